@@ -0,0 +1,153 @@
+// Command metadata-server serves ERC1155 metadata for SuperPositions and watches that the on-chain dynamicURI
+// points at it.
+//
+//	metadata-server -base-uri https://api.example.com/superpositions/ -rpc 1=https://eth.rpc -rpc 10=https://op.rpc -from-block 1=19000000
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/pkg/catalog"
+	"github.com/superform-xyz/superform-core/pkg/deployments"
+	"github.com/superform-xyz/superform-core/pkg/metadata"
+)
+
+type rpcFlag map[uint64]string
+
+func (r rpcFlag) String() string { return fmt.Sprint(map[uint64]string(r)) }
+
+func (r rpcFlag) Set(v string) error {
+	id, url, ok := strings.Cut(v, "=")
+	if !ok {
+		return fmt.Errorf("expected chainId=url, got %q", v)
+	}
+	chainID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	r[chainID] = url
+	return nil
+}
+
+type blockFlag map[uint64]uint64
+
+func (b blockFlag) String() string { return fmt.Sprint(map[uint64]uint64(b)) }
+
+func (b blockFlag) Set(v string) error {
+	id, block, ok := strings.Cut(v, "=")
+	if !ok {
+		return fmt.Errorf("expected chainId=block, got %q", v)
+	}
+	chainID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	if b[chainID], err = strconv.ParseUint(block, 10, 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	rpcs := make(rpcFlag)
+	fromBlocks := make(blockFlag)
+	var (
+		listen   = flag.String("listen", ":8080", "HTTP listen address")
+		baseURI  = flag.String("base-uri", "", "public base URI SuperPositions.dynamicURI must be set to")
+		dir      = flag.String("deployments", deployments.DefaultDir, "address book directory")
+		interval = flag.Duration("interval", time.Minute, "dynamicURI check interval")
+		blocks   = flag.Uint64("block-range", metadata.DefaultBlockRange, "blocks per log query")
+	)
+	flag.Var(rpcs, "rpc", "chainId=url RPC endpoint, repeatable")
+	flag.Var(fromBlocks, "from-block", "chainId=block first block scanned for uri events, repeatable (default: head)")
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+
+	if err := run(*listen, *baseURI, *dir, *interval, *blocks, rpcs, fromBlocks); err != nil {
+		log.Crit("Metadata server failed", "err", err)
+	}
+}
+
+func run(listen, baseURI, dir string, interval time.Duration, blockRange uint64, rpcs map[uint64]string, fromBlocks map[uint64]uint64) error {
+	if baseURI == "" {
+		return fmt.Errorf("-base-uri is required")
+	}
+	books, err := deployments.Load(dir)
+	if err != nil {
+		return err
+	}
+	for chainID := range fromBlocks {
+		if _, ok := rpcs[chainID]; !ok {
+			return fmt.Errorf("-from-block for chain %d without -rpc", chainID)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	chains := make(map[uint64]catalog.Chain)
+	names := make(map[uint64]string)
+	var watched []metadata.WatchedChain
+	for chainID, url := range rpcs {
+		book, ok := books[chainID]
+		if !ok {
+			return fmt.Errorf("no deployment for chain %d", chainID)
+		}
+		factory, err := book.MustAddress("SuperformFactory")
+		if err != nil {
+			return err
+		}
+		positions, err := book.MustAddress("SuperPositions")
+		if err != nil {
+			return err
+		}
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			return fmt.Errorf("chain %d: %w", chainID, err)
+		}
+		defer client.Close()
+
+		chains[chainID] = catalog.Chain{Backend: client, Factory: factory}
+		names[chainID] = book.Name
+		watched = append(watched, metadata.WatchedChain{
+			ChainID:        chainID,
+			Backend:        client,
+			SuperPositions: positions,
+			FromBlock:      fromBlocks[chainID],
+		})
+	}
+
+	server, err := metadata.NewServer(catalog.New(chains), baseURI, names)
+	if err != nil {
+		return err
+	}
+	watcher, err := metadata.NewWatcher(baseURI, watched, blockRange)
+	if err != nil {
+		return err
+	}
+	server.SetWatcher(watcher)
+	go watcher.Run(ctx, interval)
+
+	srv := &http.Server{Addr: listen, Handler: server}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+	log.Info("Serving SuperPositions metadata", "listen", listen, "baseURI", baseURI, "chains", len(chains))
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
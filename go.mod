@@ -2,7 +2,10 @@ module github.com/superform-xyz/superform-core
 
 go 1.22.5
 
-require github.com/ethereum/go-ethereum v1.14.8
+require (
+	github.com/ethereum/go-ethereum v1.14.8
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/Microsoft/go-winio v0.6.2 // indirect
@@ -0,0 +1,185 @@
+package ambtopup
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Adapter identifies an AMB implementation in src/crosschain-data/adapters.
+type Adapter uint8
+
+const (
+	LayerZeroV1 Adapter = iota + 1
+	LayerZeroV2
+	Hyperlane
+	WormholeAR
+	Axelar
+)
+
+func (a Adapter) String() string {
+	switch a {
+	case LayerZeroV1:
+		return "LayerzeroImplementation"
+	case LayerZeroV2:
+		return "LayerzeroV2Implementation"
+	case Hyperlane:
+		return "HyperlaneImplementation"
+	case WormholeAR:
+		return "WormholeARImplementation"
+	case Axelar:
+		return "AxelarImplementation"
+	default:
+		return fmt.Sprintf("Adapter(%d)", uint8(a))
+	}
+}
+
+// DefaultAdapters maps the AMB ids registered by the deployment scripts to their implementation. Not every chain
+// registers every id, e.g. Axelar (8) is only deployed on the chains up to Blast.
+var DefaultAdapters = map[uint8]Adapter{
+	5: LayerZeroV2,
+	6: Hyperlane,
+	7: WormholeAR,
+	8: Axelar,
+	9: LayerZeroV1,
+}
+
+// Retry is the data_ argument of IAmbImplementation.retryPayload for one adapter, as forwarded by
+// PayMaster.treatAMB.
+type Retry interface {
+	Adapter() Adapter
+	Encode() ([]byte, error)
+}
+
+// LayerZeroV1Retry retries a payload stored by the LayerZero v1 endpoint after a failed delivery. It is sent on the
+// destination chain and the endpoint does not take value: the stored payload is re-executed with the gas of the
+// retrying transaction.
+type LayerZeroV1Retry struct {
+	SrcChainID uint16
+	// SrcAddress is the trusted remote path, abi.encodePacked(remote, local).
+	SrcAddress []byte
+	Payload    []byte
+}
+
+func (LayerZeroV1Retry) Adapter() Adapter { return LayerZeroV1 }
+
+// Encode returns abi.encode(uint16 srcChainId, bytes srcAddress, bytes payload).
+func (r LayerZeroV1Retry) Encode() ([]byte, error) {
+	return abi.Arguments{
+		{Type: mustType("uint16", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("bytes", nil)},
+	}.Pack(r.SrcChainID, r.SrcAddress, r.Payload)
+}
+
+// LayerZeroV2Origin is the LayerZero v2 Origin struct.
+type LayerZeroV2Origin struct {
+	SrcEid uint32
+	Sender [32]byte
+	Nonce  uint64
+}
+
+// LayerZeroV2Retry re-executes a verified LayerZero v2 message through endpoint.lzReceive on the destination
+// chain. The treatAMB value is forwarded to lzReceive.
+type LayerZeroV2Retry struct {
+	Origin    LayerZeroV2Origin
+	Receiver  common.Address
+	GUID      [32]byte
+	Message   []byte
+	ExtraData []byte
+}
+
+func (LayerZeroV2Retry) Adapter() Adapter { return LayerZeroV2 }
+
+// Encode returns abi.encode(Origin origin, address receiver, bytes32 guid, bytes message, bytes extraData).
+func (r LayerZeroV2Retry) Encode() ([]byte, error) {
+	origin := mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "srcEid", Type: "uint32"},
+		{Name: "sender", Type: "bytes32"},
+		{Name: "nonce", Type: "uint64"},
+	})
+	return abi.Arguments{
+		{Type: origin},
+		{Type: mustType("address", nil)},
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("bytes", nil)},
+	}.Pack(r.Origin, r.Receiver, r.GUID, r.Message, r.ExtraData)
+}
+
+// HyperlaneTopUp pays the interchain gas paymaster for more destination gas on a dispatched message. The value must
+// cover igp.quoteGasPayment(DestinationDomain, GasAmount).
+type HyperlaneTopUp struct {
+	MessageID         [32]byte
+	DestinationDomain uint32
+	GasAmount         *big.Int
+}
+
+func (HyperlaneTopUp) Adapter() Adapter { return Hyperlane }
+
+// Encode returns abi.encode(bytes32 messageId, uint32 destinationDomain, uint256 gasAmount).
+func (r HyperlaneTopUp) Encode() ([]byte, error) {
+	return abi.Arguments{
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("uint32", nil)},
+		{Type: mustType("uint256", nil)},
+	}.Pack(r.MessageID, r.DestinationDomain, r.GasAmount)
+}
+
+// WormholeVaaKey is the Wormhole VaaKey struct identifying a delivery.
+type WormholeVaaKey struct {
+	ChainId        uint16
+	EmitterAddress [32]byte
+	Sequence       uint64
+}
+
+// WormholeTopUp requests a redelivery of a Wormhole automatic relayer message with a new gas limit. The value must
+// cover relayer.quoteEVMDeliveryPrice(TargetChain, 0, NewGasLimit); any excess is refunded to PayMaster.
+type WormholeTopUp struct {
+	DeliveryVaaKey      WormholeVaaKey
+	TargetChain         uint16
+	NewReceiverValue    *big.Int
+	NewGasLimit         *big.Int
+	NewDeliveryProvider common.Address
+}
+
+func (WormholeTopUp) Adapter() Adapter { return WormholeAR }
+
+// Encode returns abi.encode(VaaKey deliveryVaaKey, uint16 targetChain, uint256 newReceiverValue,
+// uint256 newGasLimit, address newDeliveryProviderAddress).
+func (r WormholeTopUp) Encode() ([]byte, error) {
+	vaaKey := mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "chainId", Type: "uint16"},
+		{Name: "emitterAddress", Type: "bytes32"},
+		{Name: "sequence", Type: "uint64"},
+	})
+	receiverValue := r.NewReceiverValue
+	if receiverValue == nil {
+		receiverValue = new(big.Int)
+	}
+	return abi.Arguments{
+		{Type: vaaKey},
+		{Type: mustType("uint16", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("address", nil)},
+	}.Pack(r.DeliveryVaaKey, r.TargetChain, receiverValue, r.NewGasLimit, r.NewDeliveryProvider)
+}
+
+// AxelarTopUp adds native gas to the Axelar gas service for the ContractCall emitted at TxHash/LogIndex.
+type AxelarTopUp struct {
+	TxHash   common.Hash
+	LogIndex *big.Int
+}
+
+func (AxelarTopUp) Adapter() Adapter { return Axelar }
+
+// Encode returns abi.encode(bytes32 txHash, uint256 logIndex).
+func (r AxelarTopUp) Encode() ([]byte, error) {
+	return abi.Arguments{
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("uint256", nil)},
+	}.Pack([32]byte(r.TxHash), r.LogIndex)
+}
@@ -0,0 +1,121 @@
+package ambtopup
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var ambExtraDataArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+	{Name: "gasPerAMB", Type: "uint256[]"},
+	{Name: "extraDataPerAMB", Type: "bytes[]"},
+})}}
+
+func mustType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// AMBExtraData is the extraData_ of BaseStateRegistry.dispatchPayload, as built by
+// PaymentHelper.calculateAMBData: the fee forwarded to and the adapter parameters of each AMB, in ambIds_ order.
+type AMBExtraData struct {
+	GasPerAMB       []*big.Int
+	ExtraDataPerAMB [][]byte
+}
+
+// DecodeAMBExtraData decodes abi.encode(AMBExtraData).
+func DecodeAMBExtraData(data []byte) (AMBExtraData, error) {
+	out, err := ambExtraDataArgs.Unpack(data)
+	if err != nil {
+		return AMBExtraData{}, err
+	}
+	var d AMBExtraData
+	if err := ambExtraDataArgs.Copy(&d, out); err != nil {
+		return AMBExtraData{}, err
+	}
+	if len(d.GasPerAMB) != len(d.ExtraDataPerAMB) {
+		return AMBExtraData{}, errors.New("ambtopup: gasPerAMB and extraDataPerAMB length mismatch")
+	}
+	return d, nil
+}
+
+// Encode encodes d as dispatchPayload extraData_.
+func (d AMBExtraData) Encode() ([]byte, error) {
+	return ambExtraDataArgs.Pack(d)
+}
+
+// Dispatch is one CoreStateRegistry.dispatchPayload call: the message sent from the source chain and the fees
+// paid to each AMB for it.
+type Dispatch struct {
+	// PayloadID is the source chain payload id (SuperformRouter's payloadIds, the SuperPositions txHistory key)
+	// the dispatch belongs to.
+	PayloadID  *big.Int
+	SrcSender  common.Address
+	AmbIDs     []uint8
+	DstChainID uint64
+	Message    []byte
+	ExtraData  AMBExtraData
+}
+
+// Index returns the position of ambID in the dispatch. Index 0 is the AMB carrying the message, the others carry
+// its proof.
+func (d Dispatch) Index(ambID uint8) (int, bool) {
+	for i, id := range d.AmbIDs {
+		if id == ambID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Paid returns the fee forwarded to ambID.
+func (d Dispatch) Paid(ambID uint8) (*big.Int, error) {
+	i, ok := d.Index(ambID)
+	if !ok || i >= len(d.ExtraData.GasPerAMB) {
+		return nil, fmt.Errorf("ambtopup: amb %d not part of dispatch", ambID)
+	}
+	return d.ExtraData.GasPerAMB[i], nil
+}
+
+// DecodeDispatchPayload decodes the calldata of a CoreStateRegistry.dispatchPayload call, e.g. taken from a call
+// trace of the routing transaction, into a Dispatch for payloadID.
+func DecodeDispatchPayload(payloadID *big.Int, input []byte) (Dispatch, error) {
+	parsed, err := contracts.CoreStateRegistryMetaData.GetAbi()
+	if err != nil {
+		return Dispatch{}, err
+	}
+	if len(input) < 4 {
+		return Dispatch{}, errors.New("ambtopup: calldata too short")
+	}
+	method, err := parsed.MethodById(input[:4])
+	if err != nil {
+		return Dispatch{}, err
+	}
+	if method.Name != "dispatchPayload" {
+		return Dispatch{}, fmt.Errorf("ambtopup: calldata is %s, not dispatchPayload", method.Name)
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return Dispatch{}, err
+	}
+	extraData, err := DecodeAMBExtraData(args[4].([]byte))
+	if err != nil {
+		return Dispatch{}, err
+	}
+	return Dispatch{
+		PayloadID:  payloadID,
+		SrcSender:  args[0].(common.Address),
+		AmbIDs:     args[1].([]uint8),
+		DstChainID: args[2].(uint64),
+		Message:    args[3].([]byte),
+		ExtraData:  extraData,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package ambtopup
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// igpABI is the slice of IInterchainGasPaymaster HyperlaneImplementation.retryPayload prices a top-up with.
+const igpABI = `[{"type":"function","name":"quoteGasPayment","inputs":[{"name":"_destinationDomain","type":"uint32","internalType":"uint32"},{"name":"_gasAmount","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"}]`
+
+// wormholeRelayerABI is the slice of IWormholeRelayer WormholeARImplementation.retryPayload prices a redelivery
+// with.
+const wormholeRelayerABI = `[{"type":"function","name":"quoteEVMDeliveryPrice","inputs":[{"name":"targetChain","type":"uint16","internalType":"uint16"},{"name":"receiverValue","type":"uint256","internalType":"uint256"},{"name":"gasLimit","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"nativePriceQuote","type":"uint256","internalType":"uint256"},{"name":"targetChainRefundPerGasUnused","type":"uint256","internalType":"uint256"}],"stateMutability":"view"}]`
+
+// Quote returns the nativeValue_ retryPayload of ambID needs for retry, which is the full price of the retry and
+// not the shortfall of the original dispatch:
+//
+//   - Hyperlane: igp.quoteGasPayment(DestinationDomain, GasAmount), as retryPayload reverts with
+//     INVALID_RETRY_FEE below it.
+//   - WormholeAR: relayer.quoteEVMDeliveryPrice(TargetChain, 0, NewGasLimit), same revert.
+//   - Axelar: the shortfall, added to the gas already paid to the gas service.
+//   - LayerZero v1 and v2: nothing; the retry re-executes with the caller's gas and value would be stranded.
+func (m *Manager) Quote(ctx context.Context, ambID uint8, shortfall Shortfall, retry Retry) (*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	switch r := retry.(type) {
+	case HyperlaneTopUp:
+		impl, err := m.implementation(opts, ambID)
+		if err != nil {
+			return nil, err
+		}
+		hyperlane, err := contracts.NewHyperlaneImplementationCaller(impl, m.backend)
+		if err != nil {
+			return nil, err
+		}
+		igp, err := hyperlane.Igp(opts)
+		if err != nil {
+			return nil, err
+		}
+		var out []interface{}
+		if err := m.call(opts, igp, igpABI, &out, "quoteGasPayment", r.DestinationDomain, r.GasAmount); err != nil {
+			return nil, fmt.Errorf("ambtopup: quote hyperlane gas: %w", err)
+		}
+		return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+	case WormholeTopUp:
+		impl, err := m.implementation(opts, ambID)
+		if err != nil {
+			return nil, err
+		}
+		wormhole, err := contracts.NewWormholeARImplementationCaller(impl, m.backend)
+		if err != nil {
+			return nil, err
+		}
+		relayer, err := wormhole.Relayer(opts)
+		if err != nil {
+			return nil, err
+		}
+		var out []interface{}
+		if err := m.call(opts, relayer, wormholeRelayerABI, &out, "quoteEVMDeliveryPrice", r.TargetChain, new(big.Int), r.NewGasLimit); err != nil {
+			return nil, fmt.Errorf("ambtopup: quote wormhole redelivery: %w", err)
+		}
+		return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+	case AxelarTopUp:
+		return shortfall.Missing(), nil
+	default:
+		return new(big.Int), nil
+	}
+}
+
+// implementation returns the AMB implementation SuperRegistry registers under ambID, the contract PayMaster
+// forwards the retry to.
+func (m *Manager) implementation(opts *bind.CallOpts, ambID uint8) (common.Address, error) {
+	registryAddr, err := m.payMaster.SuperRegistry(opts)
+	if err != nil {
+		return common.Address{}, err
+	}
+	registry, err := contracts.NewSuperRegistryCaller(registryAddr, m.backend)
+	if err != nil {
+		return common.Address{}, err
+	}
+	// ambAddresses reads zero for an unregistered id where getAmbAddress reverts.
+	impl, err := registry.AmbAddresses(opts, ambID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if impl == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("ambtopup: amb %d not registered", ambID)
+	}
+	return impl, nil
+}
+
+func (m *Manager) call(opts *bind.CallOpts, addr common.Address, abiJSON string, out *[]interface{}, method string, args ...interface{}) error {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return err
+	}
+	return bind.NewBoundContract(addr, parsed, m.backend, nil, nil).Call(opts, out, method, args...)
+}
@@ -0,0 +1,187 @@
+// Package ambtopup tops up underpaid cross-chain messages through PayMaster.treatAMB.
+//
+// treatAMB forwards nativeValue_ and data_ to retryPayload of the AMB implementation registered under ambId_. The
+// package builds data_ for each adapter in src/crosschain-data/adapters, computes how much a dispatch was underpaid
+// by re-estimating its fees with PaymentHelper against what its dispatchPayload extraData_ forwarded, and sends the
+// top-up as an Operation tied to the source payload id.
+package ambtopup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	// ErrAdapterMismatch is returned when the retry data targets another adapter than the one registered under the
+	// AMB id.
+	ErrAdapterMismatch = errors.New("ambtopup: retry data does not match amb adapter")
+	// ErrInsufficientBalance is returned when PayMaster cannot fund the top-up; treatAMB would revert with
+	// FAILED_TO_SEND_NATIVE.
+	ErrInsufficientBalance = errors.New("ambtopup: paymaster balance below top-up value")
+)
+
+// Shortfall compares what a dispatch paid an AMB with the current fee estimate.
+type Shortfall struct {
+	AmbID    uint8
+	Paid     *big.Int
+	Required *big.Int
+}
+
+// Missing returns how much the AMB was underpaid, zero when the dispatch paid enough.
+func (s Shortfall) Missing() *big.Int {
+	missing := new(big.Int).Sub(s.Required, s.Paid)
+	if missing.Sign() < 0 {
+		return new(big.Int)
+	}
+	return missing
+}
+
+// Operation is one top-up sent through PayMaster.treatAMB.
+type Operation struct {
+	// ID links the top-up to its payload: "<payloadId>/<ambId>".
+	ID        string
+	PayloadID *big.Int
+	AmbID     uint8
+	Adapter   Adapter
+	Shortfall Shortfall
+	Value     *big.Int
+	Data      []byte
+	TxHash    common.Hash
+	Time      time.Time
+}
+
+// OperationID returns the Operation.ID of a top-up of ambID for payloadID.
+func OperationID(payloadID *big.Int, ambID uint8) string {
+	return fmt.Sprintf("%s/%d", payloadID, ambID)
+}
+
+// Backend is the chain access the Manager needs.
+type Backend interface {
+	bind.ContractBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Manager computes and sends top-ups on the source chain of a dispatch.
+type Manager struct {
+	backend       Backend
+	payMasterAddr common.Address
+	payMaster     *contracts.PayMaster
+	paymentHelper *contracts.PaymentHelperCaller
+	adapters      map[uint8]Adapter
+
+	// Record, when set, is called with every sent operation, e.g. to persist it.
+	Record func(Operation) error
+}
+
+// NewManager creates a Manager for the PayMaster and PaymentHelper of one chain. adapters maps AMB ids to their
+// implementation; nil uses DefaultAdapters.
+func NewManager(backend Backend, payMaster, paymentHelper common.Address, adapters map[uint8]Adapter) (*Manager, error) {
+	pm, err := contracts.NewPayMaster(payMaster, backend)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := contracts.NewPaymentHelperCaller(paymentHelper, backend)
+	if err != nil {
+		return nil, err
+	}
+	if adapters == nil {
+		adapters = DefaultAdapters
+	}
+	return &Manager{
+		backend:       backend,
+		payMasterAddr: payMaster,
+		payMaster:     pm,
+		paymentHelper: helper,
+		adapters:      adapters,
+	}, nil
+}
+
+// Shortfall re-estimates the fee of ambID for dispatch. A non-nil extraData replaces the adapter parameters the
+// dispatch used, e.g. to price a higher destination gas limit.
+func (m *Manager) Shortfall(ctx context.Context, dispatch Dispatch, ambID uint8, extraData []byte) (Shortfall, error) {
+	i, ok := dispatch.Index(ambID)
+	if !ok {
+		return Shortfall{}, fmt.Errorf("ambtopup: amb %d not part of payload %s", ambID, dispatch.PayloadID)
+	}
+	paid, err := dispatch.Paid(ambID)
+	if err != nil {
+		return Shortfall{}, err
+	}
+	if extraData == nil {
+		extraData = dispatch.ExtraData.ExtraDataPerAMB[i]
+	}
+	_, fees, err := m.paymentHelper.EstimateAMBFees(
+		&bind.CallOpts{Context: ctx}, []uint8{ambID}, dispatch.DstChainID, dispatch.Message, [][]byte{extraData},
+	)
+	if err != nil {
+		return Shortfall{}, err
+	}
+	return Shortfall{AmbID: ambID, Paid: paid, Required: fees[0]}, nil
+}
+
+// TopUp sends retry for ambID of dispatch from opts.From, which must hold PAYMENT_ADMIN_ROLE. When opts.Value is
+// nil the price Quote returns is sent as nativeValue_; the shortfall is only recorded on the Operation. PayMaster
+// pays the value, so opts.Value is never attached to the transaction itself.
+func (m *Manager) TopUp(opts *bind.TransactOpts, dispatch Dispatch, ambID uint8, retry Retry) (Operation, error) {
+	if adapter, ok := m.adapters[ambID]; !ok || adapter != retry.Adapter() {
+		return Operation{}, fmt.Errorf("%w: amb %d is %s, data is for %s", ErrAdapterMismatch, ambID, m.adapters[ambID], retry.Adapter())
+	}
+	data, err := retry.Encode()
+	if err != nil {
+		return Operation{}, err
+	}
+
+	shortfall, err := m.Shortfall(opts.Context, dispatch, ambID, nil)
+	if err != nil {
+		return Operation{}, err
+	}
+	var value *big.Int
+	if opts.Value != nil {
+		value = new(big.Int).Set(opts.Value)
+	} else if value, err = m.Quote(opts.Context, ambID, shortfall, retry); err != nil {
+		return Operation{}, err
+	}
+
+	balance, err := m.backend.BalanceAt(opts.Context, m.payMasterAddr, nil)
+	if err != nil {
+		return Operation{}, err
+	}
+	if balance.Cmp(value) < 0 {
+		return Operation{}, fmt.Errorf("%w: has %s, needs %s", ErrInsufficientBalance, balance, value)
+	}
+
+	txOpts := *opts
+	txOpts.Value = nil
+	tx, err := m.payMaster.TreatAMB(&txOpts, ambID, value, data)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	op := Operation{
+		ID:        OperationID(dispatch.PayloadID, ambID),
+		PayloadID: dispatch.PayloadID,
+		AmbID:     ambID,
+		Adapter:   retry.Adapter(),
+		Shortfall: shortfall,
+		Value:     value,
+		Data:      data,
+		TxHash:    tx.Hash(),
+		Time:      time.Now(),
+	}
+	log.Info("Sent AMB top-up", "op", op.ID, "adapter", op.Adapter, "value", value, "missing", shortfall.Missing(), "tx", op.TxHash)
+	if m.Record != nil {
+		if err := m.Record(op); err != nil {
+			return op, err
+		}
+	}
+	return op, nil
+}
@@ -0,0 +1,501 @@
+package ambtrace
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/ambtopup"
+)
+
+// Events of the messaging layers under the adapters. The adapters emit nothing when they send or receive, so
+// messages are followed through the contracts they call.
+var (
+	// LayerZero v2 EndpointV2. The packet is PacketV1Codec encoded: version, nonce, srcEid, sender, dstEid,
+	// receiver, guid, message.
+	lzPacketSent      = crypto.Keccak256Hash([]byte("PacketSent(bytes,bytes,address)"))
+	lzPacketVerified  = crypto.Keccak256Hash([]byte("PacketVerified((uint32,bytes32,uint64),address,bytes32)"))
+	lzPacketDelivered = crypto.Keccak256Hash([]byte("PacketDelivered((uint32,bytes32,uint64),address)"))
+	// LayerZero v1 UltraLightNodeV2, the messaging library of the v1 endpoint. The packet is
+	// abi.encodePacked(nonce, srcChainId, ua, dstChainId, dstAddress, payload).
+	lzV1Packet         = crypto.Keccak256Hash([]byte("Packet(bytes)"))
+	lzV1PacketReceived = crypto.Keccak256Hash([]byte("PacketReceived(uint16,bytes,address,uint64,bytes32)"))
+	// Hyperlane Mailbox. The message is version, nonce, origin, sender, destination, recipient, body and its id is
+	// its hash.
+	hyperlaneDispatch  = crypto.Keccak256Hash([]byte("Dispatch(address,uint32,bytes32,bytes)"))
+	hyperlaneProcessID = crypto.Keccak256Hash([]byte("ProcessId(bytes32)"))
+	// Wormhole core and WormholeRelayer. The relayer publishes a delivery instruction for each send.
+	wormholeLogMessagePublished = crypto.Keccak256Hash([]byte("LogMessagePublished(address,uint64,uint32,bytes,uint8)"))
+	wormholeDelivery            = crypto.Keccak256Hash([]byte("Delivery(address,uint16,uint64,bytes32,uint8,uint256,uint8,bytes,bytes)"))
+	// Axelar gateway.
+	axelarContractCall         = crypto.Keccak256Hash([]byte("ContractCall(address,string,string,bytes32,bytes)"))
+	axelarContractCallApproved = crypto.Keccak256Hash([]byte("ContractCallApproved(bytes32,string,string,address,bytes32,bytes32,uint256)"))
+	axelarContractCallExecuted = crypto.Keccak256Hash([]byte("ContractCallExecuted(bytes32)"))
+
+	lzPacketSentArgs = abi.Arguments{
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("address", nil)},
+	}
+	// lzOriginArgs decodes the data of PacketDelivered; PacketVerified appends the payload hash.
+	lzOriginArgs = abi.Arguments{
+		{Type: mustType("uint32", nil)},
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("uint64", nil)},
+		{Type: mustType("address", nil)},
+	}
+	lzV1PacketReceivedArgs = abi.Arguments{
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("uint64", nil)},
+		{Type: mustType("bytes32", nil)},
+	}
+	bytesArgs                       = abi.Arguments{{Type: mustType("bytes", nil)}}
+	wormholeLogMessagePublishedArgs = abi.Arguments{
+		{Type: mustType("uint64", nil)},
+		{Type: mustType("uint32", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("uint8", nil)},
+	}
+	wormholeDeliveryArgs = abi.Arguments{
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("uint8", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("uint8", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("bytes", nil)},
+	}
+	axelarContractCallArgs = abi.Arguments{
+		{Type: mustType("string", nil)},
+		{Type: mustType("string", nil)},
+		{Type: mustType("bytes", nil)},
+	}
+	axelarContractCallApprovedArgs = abi.Arguments{
+		{Type: mustType("string", nil)},
+		{Type: mustType("string", nil)},
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("uint256", nil)},
+	}
+)
+
+// lzEndpointV1ABI is the slice of ILayerZeroEndpoint the LayerZero v1 receive library is read with.
+const lzEndpointV1ABI = `[{"type":"function","name":"getReceiveLibraryAddress","inputs":[{"name":"userApplication_","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"}]`
+
+// sent is a message found in a dispatch transaction.
+type sent struct {
+	Message
+	// ambChainID and ambChainName are the destination in the AMB's own chain ids: a LayerZero eid or chain id, a
+	// Hyperlane domain or a Wormhole chain id, or an Axelar chain name.
+	ambChainID   uint32
+	ambChainName string
+	// payload is the message_ handed to the adapter, abi.encode(AMBMessage).
+	payload []byte
+}
+
+// endpoint is the adapter of one AMB id on one chain, together with the contract it sends and receives through.
+type endpoint interface {
+	// sent parses l as a message sent by the adapter.
+	sent(l types.Log) (sent, bool)
+	// superChainID maps the destination of s to its chain id.
+	superChainID(opts *bind.CallOpts, s sent) (uint64, error)
+	// delivery finds the transaction delivering m from srcChainID to the adapter. A delivery the AMB reports as
+	// successful is Received; the caller checks the state registry received it.
+	delivery(ctx context.Context, opts *bind.CallOpts, srcChainID uint64, d Dispatch, m Message, fromBlock uint64) (Delivery, error)
+}
+
+// newEndpoint binds the adapter impl of kind on chain and reads the contract it goes through.
+func newEndpoint(opts *bind.CallOpts, kind ambtopup.Adapter, ambID uint8, impl common.Address, chain Chain) (endpoint, error) {
+	backend := chain.Backend
+	base := adapterEndpoint{ambID: ambID, kind: kind, impl: impl, backend: backend, blockRange: chain.BlockRange}
+	if base.blockRange == 0 {
+		base.blockRange = DefaultBlockRange
+	}
+	switch kind {
+	case ambtopup.LayerZeroV2:
+		c, err := contracts.NewLayerzeroV2ImplementationCaller(impl, backend)
+		if err != nil {
+			return nil, err
+		}
+		base.contract, err = c.Endpoint(opts)
+		if err != nil {
+			return nil, fmt.Errorf("ambtrace: %s.endpoint(): %w", kind, err)
+		}
+		return lzV2Endpoint{adapterEndpoint: base, caller: c}, nil
+	case ambtopup.LayerZeroV1:
+		c, err := contracts.NewLayerzeroImplementationCaller(impl, backend)
+		if err != nil {
+			return nil, err
+		}
+		lzEndpoint, err := c.LzEndpoint(opts)
+		if err != nil {
+			return nil, fmt.Errorf("ambtrace: %s.lzEndpoint(): %w", kind, err)
+		}
+		parsed, err := abi.JSON(strings.NewReader(lzEndpointV1ABI))
+		if err != nil {
+			return nil, err
+		}
+		var out []interface{}
+		if err := bind.NewBoundContract(lzEndpoint, parsed, backend, nil, nil).Call(opts, &out, "getReceiveLibraryAddress", impl); err != nil {
+			return nil, fmt.Errorf("ambtrace: %s getReceiveLibraryAddress: %w", kind, err)
+		}
+		base.contract = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+		return lzV1Endpoint{adapterEndpoint: base, caller: c}, nil
+	case ambtopup.Hyperlane:
+		c, err := contracts.NewHyperlaneImplementationCaller(impl, backend)
+		if err != nil {
+			return nil, err
+		}
+		base.contract, err = c.Mailbox(opts)
+		if err != nil {
+			return nil, fmt.Errorf("ambtrace: %s.mailbox(): %w", kind, err)
+		}
+		return hyperlaneEndpoint{adapterEndpoint: base, caller: c}, nil
+	case ambtopup.WormholeAR:
+		c, err := contracts.NewWormholeARImplementationCaller(impl, backend)
+		if err != nil {
+			return nil, err
+		}
+		base.contract, err = c.Relayer(opts)
+		if err != nil {
+			return nil, fmt.Errorf("ambtrace: %s.relayer(): %w", kind, err)
+		}
+		return wormholeEndpoint{adapterEndpoint: base, caller: c}, nil
+	case ambtopup.Axelar:
+		c, err := contracts.NewAxelarImplementationCaller(impl, backend)
+		if err != nil {
+			return nil, err
+		}
+		base.contract, err = c.Gateway(opts)
+		if err != nil {
+			return nil, fmt.Errorf("ambtrace: %s.gateway(): %w", kind, err)
+		}
+		return axelarEndpoint{adapterEndpoint: base, caller: c}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAdapter, kind)
+	}
+}
+
+type adapterEndpoint struct {
+	ambID uint8
+	kind  ambtopup.Adapter
+	impl  common.Address
+	// contract is the LayerZero v2 endpoint, the Hyperlane mailbox, the Wormhole relayer or the Axelar gateway.
+	// For LayerZero v1 it is the receive library of the adapter; packets are emitted by its send library, which
+	// sent does not check.
+	contract   common.Address
+	backend    Backend
+	blockRange uint64
+}
+
+func (e adapterEndpoint) message(l types.Log) Message {
+	return Message{AMBID: e.ambID, Adapter: e.kind, Implementation: e.impl, LogIndex: l.Index}
+}
+
+// filter returns the logs matching topics at address from fromBlock to the head, querying blockRange blocks at a
+// time.
+func (e adapterEndpoint) filter(ctx context.Context, address common.Address, fromBlock uint64, topics ...[]common.Hash) ([]types.Log, error) {
+	head, err := e.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	to := head.Number.Uint64()
+	q := ethereum.FilterQuery{Addresses: []common.Address{address}, Topics: topics}
+	var out []types.Log
+	for from := fromBlock; from <= to; from += e.blockRange {
+		end := min(from+e.blockRange-1, to)
+		q.FromBlock, q.ToBlock = new(big.Int).SetUint64(from), new(big.Int).SetUint64(end)
+		logs, err := e.backend.FilterLogs(ctx, q)
+		if err != nil {
+			return nil, fmt.Errorf("logs %d-%d: %w", from, end, err)
+		}
+		out = append(out, logs...)
+	}
+	return out, nil
+}
+
+type lzV2Endpoint struct {
+	adapterEndpoint
+	caller *contracts.LayerzeroV2ImplementationCaller
+}
+
+func (e lzV2Endpoint) sent(l types.Log) (sent, bool) {
+	if l.Address != e.contract || len(l.Topics) == 0 || l.Topics[0] != lzPacketSent {
+		return sent{}, false
+	}
+	fields, err := lzPacketSentArgs.Unpack(l.Data)
+	if err != nil {
+		return sent{}, false
+	}
+	packet := fields[0].([]byte)
+	if len(packet) < 113 || common.BytesToAddress(packet[13:45]) != e.impl {
+		return sent{}, false
+	}
+	s := sent{Message: e.message(l), ambChainID: binary.BigEndian.Uint32(packet[45:49]), payload: packet[113:]}
+	s.ID = common.BytesToHash(packet[81:113])
+	s.Nonce = binary.BigEndian.Uint64(packet[1:9])
+	return s, true
+}
+
+func (e lzV2Endpoint) superChainID(opts *bind.CallOpts, s sent) (uint64, error) {
+	return e.caller.SuperChainId(opts, s.ambChainID)
+}
+
+func (e lzV2Endpoint) delivery(ctx context.Context, opts *bind.CallOpts, srcChainID uint64, _ Dispatch, m Message, fromBlock uint64) (Delivery, error) {
+	srcEid, err := e.caller.AmbChainId(opts, srcChainID)
+	if err != nil {
+		return Delivery{}, err
+	}
+	match := func(l types.Log) bool {
+		fields, err := lzOriginArgs.Unpack(l.Data)
+		if err != nil {
+			return false
+		}
+		return fields[0].(uint32) == srcEid && fields[1].([32]byte) == common.BytesToHash(m.Implementation.Bytes()) &&
+			fields[2].(uint64) == m.Nonce && fields[3].(common.Address) == e.impl
+	}
+	logs, err := e.filter(ctx, e.contract, fromBlock, []common.Hash{lzPacketDelivered, lzPacketVerified})
+	if err != nil {
+		return Delivery{}, err
+	}
+	d := Delivery{Message: m, Status: Pending}
+	for _, l := range logs {
+		if !match(l) {
+			continue
+		}
+		if l.Topics[0] == lzPacketDelivered {
+			return Delivery{Message: m, Status: Received, Block: l.BlockNumber, TxHash: l.TxHash}, nil
+		}
+		d.Status = Verified
+	}
+	return d, nil
+}
+
+type lzV1Endpoint struct {
+	adapterEndpoint
+	caller *contracts.LayerzeroImplementationCaller
+}
+
+func (e lzV1Endpoint) sent(l types.Log) (sent, bool) {
+	if len(l.Topics) == 0 || l.Topics[0] != lzV1Packet {
+		return sent{}, false
+	}
+	fields, err := bytesArgs.Unpack(l.Data)
+	if err != nil {
+		return sent{}, false
+	}
+	packet := fields[0].([]byte)
+	if len(packet) < 52 || common.BytesToAddress(packet[10:30]) != e.impl {
+		return sent{}, false
+	}
+	s := sent{Message: e.message(l), ambChainID: uint32(binary.BigEndian.Uint16(packet[30:32])), payload: packet[52:]}
+	s.Nonce = binary.BigEndian.Uint64(packet[0:8])
+	return s, true
+}
+
+func (e lzV1Endpoint) superChainID(opts *bind.CallOpts, s sent) (uint64, error) {
+	return e.caller.SuperChainId(opts, uint16(s.ambChainID))
+}
+
+func (e lzV1Endpoint) delivery(ctx context.Context, opts *bind.CallOpts, srcChainID uint64, _ Dispatch, m Message, fromBlock uint64) (Delivery, error) {
+	srcLzChainID, err := e.caller.AmbChainId(opts, srcChainID)
+	if err != nil {
+		return Delivery{}, err
+	}
+	logs, err := e.filter(ctx, e.contract, fromBlock,
+		[]common.Hash{lzV1PacketReceived},
+		[]common.Hash{common.BigToHash(new(big.Int).SetUint64(uint64(srcLzChainID)))},
+		[]common.Hash{common.BytesToHash(e.impl.Bytes())},
+	)
+	if err != nil {
+		return Delivery{}, err
+	}
+	for _, l := range logs {
+		fields, err := lzV1PacketReceivedArgs.Unpack(l.Data)
+		if err != nil {
+			continue
+		}
+		srcAddress := fields[0].([]byte)
+		if len(srcAddress) < 20 || common.BytesToAddress(srcAddress[:20]) != m.Implementation || fields[1].(uint64) != m.Nonce {
+			continue
+		}
+		return Delivery{Message: m, Status: Received, Block: l.BlockNumber, TxHash: l.TxHash}, nil
+	}
+	return Delivery{Message: m, Status: Pending}, nil
+}
+
+type hyperlaneEndpoint struct {
+	adapterEndpoint
+	caller *contracts.HyperlaneImplementationCaller
+}
+
+func (e hyperlaneEndpoint) sent(l types.Log) (sent, bool) {
+	if l.Address != e.contract || len(l.Topics) != 4 || l.Topics[0] != hyperlaneDispatch ||
+		l.Topics[1] != common.BytesToHash(e.impl.Bytes()) {
+		return sent{}, false
+	}
+	fields, err := bytesArgs.Unpack(l.Data)
+	if err != nil {
+		return sent{}, false
+	}
+	message := fields[0].([]byte)
+	if len(message) < 77 {
+		return sent{}, false
+	}
+	s := sent{Message: e.message(l), ambChainID: binary.BigEndian.Uint32(message[41:45]), payload: message[77:]}
+	s.ID = crypto.Keccak256Hash(message)
+	s.Nonce = uint64(binary.BigEndian.Uint32(message[1:5]))
+	return s, true
+}
+
+func (e hyperlaneEndpoint) superChainID(opts *bind.CallOpts, s sent) (uint64, error) {
+	return e.caller.SuperChainId(opts, s.ambChainID)
+}
+
+func (e hyperlaneEndpoint) delivery(ctx context.Context, _ *bind.CallOpts, _ uint64, _ Dispatch, m Message, fromBlock uint64) (Delivery, error) {
+	logs, err := e.filter(ctx, e.contract, fromBlock, []common.Hash{hyperlaneProcessID}, []common.Hash{m.ID})
+	if err != nil {
+		return Delivery{}, err
+	}
+	if len(logs) == 0 {
+		return Delivery{Message: m, Status: Pending}, nil
+	}
+	return Delivery{Message: m, Status: Received, Block: logs[0].BlockNumber, TxHash: logs[0].TxHash}, nil
+}
+
+type wormholeEndpoint struct {
+	adapterEndpoint
+	caller *contracts.WormholeARImplementationCaller
+}
+
+// sent parses the delivery instruction the relayer publishes: payload id 1, targetChain, targetAddress, payload,
+// requestedReceiverValue, extraReceiverValue, encodedExecutionInfo, refundChain, refundAddress,
+// refundDeliveryProvider, sourceDeliveryProvider, senderAddress, messageKeys. Byte arrays are prefixed with a
+// uint32 length.
+func (e wormholeEndpoint) sent(l types.Log) (sent, bool) {
+	if len(l.Topics) != 2 || l.Topics[0] != wormholeLogMessagePublished || l.Topics[1] != common.BytesToHash(e.contract.Bytes()) {
+		return sent{}, false
+	}
+	fields, err := wormholeLogMessagePublishedArgs.Unpack(l.Data)
+	if err != nil {
+		return sent{}, false
+	}
+	instruction := fields[2].([]byte)
+	if len(instruction) < 39 || instruction[0] != 1 {
+		return sent{}, false
+	}
+	end := 39 + int(binary.BigEndian.Uint32(instruction[35:39]))
+	if len(instruction) < end+68 {
+		return sent{}, false
+	}
+	sender := end + 68 + int(binary.BigEndian.Uint32(instruction[end+64:end+68])) + 98
+	if len(instruction) < sender+32 || common.BytesToAddress(instruction[sender:sender+32]) != e.impl {
+		return sent{}, false
+	}
+	s := sent{Message: e.message(l), ambChainID: uint32(binary.BigEndian.Uint16(instruction[1:3])), payload: instruction[39:end]}
+	s.Nonce = fields[0].(uint64)
+	return s, true
+}
+
+func (e wormholeEndpoint) superChainID(opts *bind.CallOpts, s sent) (uint64, error) {
+	return e.caller.SuperChainId(opts, uint16(s.ambChainID))
+}
+
+// delivery prefers a successful delivery over a failed one, as a failed message may be redelivered.
+func (e wormholeEndpoint) delivery(ctx context.Context, opts *bind.CallOpts, srcChainID uint64, _ Dispatch, m Message, fromBlock uint64) (Delivery, error) {
+	srcWormholeChainID, err := e.caller.AmbChainId(opts, srcChainID)
+	if err != nil {
+		return Delivery{}, err
+	}
+	logs, err := e.filter(ctx, e.contract, fromBlock,
+		[]common.Hash{wormholeDelivery},
+		[]common.Hash{common.BytesToHash(e.impl.Bytes())},
+		[]common.Hash{common.BigToHash(new(big.Int).SetUint64(uint64(srcWormholeChainID)))},
+		[]common.Hash{common.BigToHash(new(big.Int).SetUint64(m.Nonce))},
+	)
+	if err != nil {
+		return Delivery{}, err
+	}
+	d := Delivery{Message: m, Status: Pending}
+	for _, l := range logs {
+		fields, err := wormholeDeliveryArgs.Unpack(l.Data)
+		if err != nil {
+			continue
+		}
+		d.Block, d.TxHash = l.BlockNumber, l.TxHash
+		// DeliveryStatus SUCCESS; RECEIVER_FAILURE means receiveWormholeMessages reverted.
+		if fields[1].(uint8) == 0 {
+			d.Status = Received
+			return d, nil
+		}
+		d.Status = Failed
+	}
+	return d, nil
+}
+
+type axelarEndpoint struct {
+	adapterEndpoint
+	caller *contracts.AxelarImplementationCaller
+}
+
+func (e axelarEndpoint) sent(l types.Log) (sent, bool) {
+	if l.Address != e.contract || len(l.Topics) != 3 || l.Topics[0] != axelarContractCall ||
+		l.Topics[1] != common.BytesToHash(e.impl.Bytes()) {
+		return sent{}, false
+	}
+	fields, err := axelarContractCallArgs.Unpack(l.Data)
+	if err != nil {
+		return sent{}, false
+	}
+	s := sent{Message: e.message(l), ambChainName: fields[0].(string), payload: fields[2].([]byte)}
+	s.ID = l.Topics[2]
+	return s, true
+}
+
+func (e axelarEndpoint) superChainID(opts *bind.CallOpts, s sent) (uint64, error) {
+	return e.caller.SuperChainId(opts, s.ambChainName)
+}
+
+// delivery finds the command approving the contract call emitted at the dispatch transaction and log index of m,
+// then its execution.
+func (e axelarEndpoint) delivery(ctx context.Context, _ *bind.CallOpts, _ uint64, d Dispatch, m Message, fromBlock uint64) (Delivery, error) {
+	logs, err := e.filter(ctx, e.contract, fromBlock,
+		[]common.Hash{axelarContractCallApproved},
+		nil,
+		[]common.Hash{common.BytesToHash(e.impl.Bytes())},
+		[]common.Hash{m.ID},
+	)
+	if err != nil {
+		return Delivery{}, err
+	}
+	out := Delivery{Message: m, Status: Pending}
+	for _, l := range logs {
+		fields, err := axelarContractCallApprovedArgs.Unpack(l.Data)
+		if err != nil {
+			continue
+		}
+		if fields[2].([32]byte) == d.TxHash && fields[3].(*big.Int).Cmp(new(big.Int).SetUint64(uint64(m.LogIndex))) == 0 {
+			out.Status, out.CommandID = Verified, l.Topics[1]
+			break
+		}
+	}
+	if out.Status == Pending {
+		return out, nil
+	}
+	logs, err = e.filter(ctx, e.contract, fromBlock, []common.Hash{axelarContractCallExecuted}, []common.Hash{out.CommandID})
+	if err != nil {
+		return Delivery{}, err
+	}
+	if len(logs) > 0 {
+		out.Status, out.Block, out.TxHash = Received, logs[0].BlockNumber, logs[0].TxHash
+	}
+	return out, nil
+}
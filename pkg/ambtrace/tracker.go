@@ -0,0 +1,471 @@
+// Package ambtrace follows state registry payloads inside the AMBs carrying them.
+//
+// BaseStateRegistry.dispatchPayload sends the payload through the first of its ambIds and a proof of it through
+// the others; the destination processes the payload once the proofs reach the chain's quorum. CoreStateRegistry
+// records which AMB ids a payload came with, but not where each message is inside its bridge. The tracker links
+// every AMB message of a dispatch to its id in the bridge (LayerZero nonce and GUID, Hyperlane message id,
+// Wormhole sequence, Axelar command id) and to the PayloadReceived or ProofReceived it produced on the
+// destination, so the AMB holding back quorum can be told apart.
+package ambtrace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/ambtopup"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+	"github.com/superform-xyz/superform-core/pkg/payloads"
+	"github.com/superform-xyz/superform-core/pkg/registry"
+)
+
+var (
+	// ErrNoDispatch is returned when a transaction did not dispatch a payload through a known adapter.
+	ErrNoDispatch = errors.New("ambtrace: transaction did not dispatch a payload")
+	// ErrUnknownAdapter is returned for an AMB id without an adapter implementation.
+	ErrUnknownAdapter = errors.New("ambtrace: unknown adapter")
+	// ErrNoFromBlock is returned when tracking into a chain without a FromBlock.
+	ErrNoFromBlock = errors.New("ambtrace: chain has no from block")
+)
+
+// DefaultBlockRange is the number of blocks the delivery searches query logs for at once unless the Chain sets
+// another.
+const DefaultBlockRange = 5000
+
+// messageParamsArgs decodes the params of the payload carrying message, abi.encode(ambIds, params).
+var messageParamsArgs = abi.Arguments{
+	{Type: mustType("uint8[]", nil)},
+	{Type: mustType("bytes", nil)},
+}
+
+// Status is how far an AMB message got on the destination chain.
+type Status uint8
+
+const (
+	// Pending means the AMB has not delivered the message.
+	Pending Status = iota
+	// Verified means the AMB verified the message but did not execute it: a LayerZero v2 packet that was not
+	// delivered or an approved Axelar call that was not executed.
+	Verified
+	// Failed means the AMB delivered the message but the state registry did not receive it, e.g. a reverted
+	// Wormhole receiver or a LayerZero v1 payload stored by the endpoint.
+	Failed
+	// Received means the state registry emitted PayloadReceived or ProofReceived for the message.
+	Received
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "PENDING"
+	case Verified:
+		return "VERIFIED"
+	case Failed:
+		return "FAILED"
+	case Received:
+		return "RECEIVED"
+	default:
+		return fmt.Sprintf("Status(%d)", uint8(s))
+	}
+}
+
+// Backend is the chain access the tracker needs.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Chain is one chain followed by the tracker. Adapters and state registries are read from its SuperRegistry.
+type Chain struct {
+	Backend       Backend
+	SuperRegistry common.Address
+	// FromBlock bounds the delivery searches, typically the deployment block of the adapters. It is required to
+	// track messages into the chain.
+	FromBlock uint64
+	// BlockRange is the number of blocks queried for logs at once; zero uses DefaultBlockRange.
+	BlockRange uint64
+}
+
+// Message is one AMB message of a dispatch.
+type Message struct {
+	AMBID   uint8
+	Adapter ambtopup.Adapter
+	// Implementation is the adapter contract that sent the message.
+	Implementation common.Address
+	// Proof reports whether the message carries the proof of the payload rather than the payload.
+	Proof bool
+	// ID is the LayerZero v2 GUID, the Hyperlane message id or the Axelar payload hash. It is zero for LayerZero v1
+	// and Wormhole, whose messages are identified by Nonce.
+	ID common.Hash
+	// Nonce is the LayerZero or Hyperlane nonce, or the Wormhole sequence. It is zero for Axelar.
+	Nonce uint64
+	// LogIndex is the index of the sending event in the block, which Axelar reports as sourceEventIndex.
+	LogIndex uint
+}
+
+// Dispatch is one BaseStateRegistry.dispatchPayload found in a source transaction.
+type Dispatch struct {
+	SrcChainID uint64
+	DstChainID uint64
+	TxHash     common.Hash
+	Block      uint64
+	TxInfo     *big.Int
+	// AMBIDs are the ambIds_ of the dispatch, the first carrying the payload. They are nil when the payload
+	// carrying message was not found.
+	AMBIDs []uint8
+	// Proof is the hash of the payload the proof messages carry and the destination counts towards quorum.
+	Proof common.Hash
+	// Messages are in AMBIDs order.
+	Messages []Message
+}
+
+// Delivery is the state of one AMB message on the destination chain.
+type Delivery struct {
+	Message Message
+	Status  Status
+	// CommandID is the Axelar command approving the message; zero for other AMBs or before approval.
+	CommandID common.Hash
+	// Block and TxHash locate the destination transaction delivering the message; they are unset until then.
+	Block  uint64
+	TxHash common.Hash
+}
+
+// Trace is a dispatch followed to its destination chain.
+type Trace struct {
+	Dispatch   Dispatch
+	Deliveries []Delivery
+	// PayloadID is the destination payload id, nil until the payload is received.
+	PayloadID *big.Int
+	// Quorum is the number of proofs the destination requires from the source chain, Proofs the number it
+	// received. Proofs are counted under the current proof of the payload once it is received, as updating a
+	// deposit payload moves its quorum to the proof of the new body, and under Dispatch.Proof before.
+	Quorum *big.Int
+	Proofs *big.Int
+}
+
+// Late returns the AMB ids whose message was not received on the destination.
+func (t Trace) Late() []uint8 {
+	var out []uint8
+	for _, d := range t.Deliveries {
+		if d.Status != Received {
+			out = append(out, d.Message.AMBID)
+		}
+	}
+	return out
+}
+
+// QuorumMet reports whether the payload was received with enough proofs to be processed.
+func (t Trace) QuorumMet() bool {
+	return t.PayloadID != nil && t.Proofs != nil && t.Quorum != nil && t.Proofs.Cmp(t.Quorum) >= 0
+}
+
+// Tracker follows dispatches from their source chain into each AMB and to the destination state registry.
+type Tracker struct {
+	chains   map[uint64]Chain
+	adapters map[uint8]ambtopup.Adapter
+}
+
+// NewTracker creates a Tracker over chains keyed by chain id. adapters maps AMB ids to their implementation; nil
+// uses ambtopup.DefaultAdapters. Ids a chain does not register are skipped on that chain.
+func NewTracker(chains map[uint64]Chain, adapters map[uint8]ambtopup.Adapter) *Tracker {
+	if adapters == nil {
+		adapters = ambtopup.DefaultAdapters
+	}
+	return &Tracker{chains: chains, adapters: adapters}
+}
+
+func (t *Tracker) chain(chainID uint64) (Chain, error) {
+	c, ok := t.chains[chainID]
+	if !ok {
+		return Chain{}, fmt.Errorf("ambtrace: unknown chain %d", chainID)
+	}
+	return c, nil
+}
+
+// endpoint binds the adapter registered for ambID on c.
+func (t *Tracker) endpoint(opts *bind.CallOpts, c Chain, ambID uint8) (endpoint, error) {
+	kind, ok := t.adapters[ambID]
+	if !ok {
+		return nil, fmt.Errorf("%w: AMB id %d", ErrUnknownAdapter, ambID)
+	}
+	superRegistry, err := contracts.NewSuperRegistryCaller(c.SuperRegistry, c.Backend)
+	if err != nil {
+		return nil, err
+	}
+	// ambAddresses reads zero for an id the chain does not register, where getAmbAddress reverts.
+	impl, err := superRegistry.AmbAddresses(opts, ambID)
+	if err != nil {
+		return nil, fmt.Errorf("ambtrace: ambAddresses(%d): %w", ambID, err)
+	}
+	if impl == (common.Address{}) {
+		return nil, fmt.Errorf("%w: AMB id %d is not registered", ErrUnknownAdapter, ambID)
+	}
+	return newEndpoint(opts, kind, ambID, impl, c)
+}
+
+// Origin returns the dispatches made by transaction txHash on chainID, ordered by destination chain. It returns
+// ErrNoDispatch when the transaction sent nothing through the adapters registered on chainID.
+func (t *Tracker) Origin(ctx context.Context, chainID uint64, txHash common.Hash) ([]Dispatch, error) {
+	c, err := t.chain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := c.Backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	ids := make([]uint8, 0, len(t.adapters))
+	for id := range t.adapters {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	var endpoints []endpoint
+	for _, id := range ids {
+		e, err := t.endpoint(opts, c, id)
+		if errors.Is(err, ErrUnknownAdapter) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ambtrace: chain %d: %w", chainID, err)
+		}
+		endpoints = append(endpoints, e)
+	}
+
+	type key struct {
+		dst   uint64
+		proof common.Hash
+	}
+	dispatches := make(map[key]*Dispatch)
+	var order []key
+	for _, l := range receipt.Logs {
+		for _, e := range endpoints {
+			s, ok := e.sent(*l)
+			if !ok {
+				continue
+			}
+			txInfo, ambIDs, proof, err := decodeMessage(s.payload)
+			if err != nil {
+				log.Warn("Undecodable AMB message", "chainId", chainID, "tx", txHash, "amb", s.AMBID, "err", err)
+				continue
+			}
+			dst, err := e.superChainID(opts, s)
+			if err != nil {
+				return nil, fmt.Errorf("ambtrace: chain %d: %s.superChainId: %w", chainID, s.Adapter, err)
+			}
+			k := key{dst, proof}
+			d, ok := dispatches[k]
+			if !ok {
+				d = &Dispatch{SrcChainID: chainID, DstChainID: dst, TxHash: txHash, Block: receipt.BlockNumber.Uint64(), TxInfo: txInfo, Proof: proof}
+				dispatches[k] = d
+				order = append(order, k)
+			}
+			s.Proof = ambIDs == nil
+			if !s.Proof {
+				d.AMBIDs = ambIDs
+			}
+			d.Messages = append(d.Messages, s.Message)
+		}
+	}
+	if len(order) == 0 {
+		return nil, fmt.Errorf("%w: %s on chain %d", ErrNoDispatch, txHash, chainID)
+	}
+
+	out := make([]Dispatch, 0, len(order))
+	for _, k := range order {
+		d := dispatches[k]
+		if d.AMBIDs != nil {
+			position := make(map[uint8]int, len(d.AMBIDs))
+			for i, id := range d.AMBIDs {
+				position[id] = i
+			}
+			sort.SliceStable(d.Messages, func(i, j int) bool {
+				return position[d.Messages[i].AMBID] < position[d.Messages[j].AMBID]
+			})
+		}
+		out = append(out, *d)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].DstChainID < out[j].DstChainID })
+	return out, nil
+}
+
+// Track follows every message of d to its destination chain and logs the AMBs that are late when the payload does
+// not have quorum.
+func (t *Tracker) Track(ctx context.Context, d Dispatch) (Trace, error) {
+	c, err := t.chain(d.DstChainID)
+	if err != nil {
+		return Trace{}, err
+	}
+	if c.FromBlock == 0 {
+		return Trace{}, fmt.Errorf("%w: chain %d", ErrNoFromBlock, d.DstChainID)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	superRegistry, err := contracts.NewSuperRegistryCaller(c.SuperRegistry, c.Backend)
+	if err != nil {
+		return Trace{}, err
+	}
+	registryAddress, err := superRegistry.GetStateRegistry(opts, datalib.DecodeTxInfo(d.TxInfo).RegistryID)
+	if err != nil {
+		return Trace{}, fmt.Errorf("ambtrace: chain %d: getStateRegistry: %w", d.DstChainID, err)
+	}
+	// Every BaseStateRegistry emits the CoreStateRegistry events and exposes its quorum views.
+	stateRegistry, err := contracts.NewCoreStateRegistry(registryAddress, c.Backend)
+	if err != nil {
+		return Trace{}, err
+	}
+
+	trace := Trace{Dispatch: d}
+	for _, m := range d.Messages {
+		e, err := t.endpoint(opts, c, m.AMBID)
+		if err != nil {
+			return trace, fmt.Errorf("ambtrace: chain %d: %w", d.DstChainID, err)
+		}
+		delivery, err := e.delivery(ctx, opts, d.SrcChainID, d, m, c.FromBlock)
+		if err != nil {
+			return trace, fmt.Errorf("ambtrace: chain %d: %s delivery: %w", d.DstChainID, m.Adapter, err)
+		}
+		if delivery.Status == Received {
+			payloadID, ok, err := t.received(ctx, opts, c, stateRegistry, registryAddress, d, delivery)
+			if err != nil {
+				return trace, fmt.Errorf("ambtrace: chain %d: %w", d.DstChainID, err)
+			}
+			if !ok {
+				delivery.Status = Failed
+			}
+			if payloadID != nil {
+				trace.PayloadID = payloadID
+			}
+		}
+		trace.Deliveries = append(trace.Deliveries, delivery)
+	}
+
+	if trace.Quorum, err = superRegistry.GetRequiredMessagingQuorum(opts, d.SrcChainID); err != nil {
+		return trace, fmt.Errorf("ambtrace: chain %d: getRequiredMessagingQuorum: %w", d.DstChainID, err)
+	}
+	proof := d.Proof
+	if trace.PayloadID != nil {
+		if proof, err = payloadProof(opts, c, superRegistry, trace.PayloadID); err != nil {
+			return trace, fmt.Errorf("ambtrace: chain %d: %w", d.DstChainID, err)
+		}
+	}
+	if trace.Proofs, err = stateRegistry.MessageQuorum(opts, proof); err != nil {
+		return trace, fmt.Errorf("ambtrace: chain %d: messageQuorum: %w", d.DstChainID, err)
+	}
+	if !trace.QuorumMet() {
+		for _, delivery := range trace.Deliveries {
+			if delivery.Status == Received {
+				continue
+			}
+			m := delivery.Message
+			log.Warn("AMB message late", "srcChainId", d.SrcChainID, "dstChainId", d.DstChainID, "tx", d.TxHash, "amb", m.AMBID, "adapter", m.Adapter, "proof", m.Proof, "id", m.ID, "nonce", m.Nonce, "status", delivery.Status)
+		}
+	}
+	log.Info("Tracked dispatch", "srcChainId", d.SrcChainID, "dstChainId", d.DstChainID, "tx", d.TxHash, "payloadId", trace.PayloadID, "proofs", trace.Proofs, "quorum", trace.Quorum, "late", len(trace.Late()))
+	return trace, nil
+}
+
+// TrackTx follows every dispatch made by txHash on chainID.
+func (t *Tracker) TrackTx(ctx context.Context, chainID uint64, txHash common.Hash) ([]Trace, error) {
+	dispatches, err := t.Origin(ctx, chainID, txHash)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Trace, 0, len(dispatches))
+	for _, d := range dispatches {
+		trace, err := t.Track(ctx, d)
+		if err != nil {
+			return out, err
+		}
+		out = append(out, trace)
+	}
+	return out, nil
+}
+
+// payloadProof returns the current proof of payloadID from the PayloadHelper registered on c.
+func payloadProof(opts *bind.CallOpts, c Chain, superRegistry *contracts.SuperRegistryCaller, payloadID *big.Int) (common.Hash, error) {
+	helperAddress, err := superRegistry.GetAddress(opts, registry.PayloadHelper)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("getAddress(PAYLOAD_HELPER): %w", err)
+	}
+	helper, err := contracts.NewPayloadHelperV2Caller(helperAddress, c.Backend)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	proof, err := helper.GetDstPayloadProof(opts, payloadID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("getDstPayloadProof %s: %w", payloadID, err)
+	}
+	return proof, nil
+}
+
+// received looks for the state registry event the delivery of a message of d produced. For the payload carrying
+// message it returns the destination payload id, matching PayloadReceived by source chain and header since the
+// payload body may already have been updated.
+func (t *Tracker) received(ctx context.Context, opts *bind.CallOpts, c Chain, stateRegistry *contracts.CoreStateRegistry, registryAddress common.Address, d Dispatch, delivery Delivery) (*big.Int, bool, error) {
+	receipt, err := c.Backend.TransactionReceipt(ctx, delivery.TxHash)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != registryAddress {
+			continue
+		}
+		if delivery.Message.Proof {
+			if ev, err := stateRegistry.ParseProofReceived(*l); err == nil && ev.Proof == d.Proof {
+				return nil, true, nil
+			}
+			continue
+		}
+		ev, err := stateRegistry.ParsePayloadReceived(*l)
+		if err != nil || ev.SrcChainId != d.SrcChainID {
+			continue
+		}
+		header, err := stateRegistry.PayloadHeader(opts, ev.PayloadId)
+		if err != nil {
+			return nil, false, fmt.Errorf("payloadHeader %s: %w", ev.PayloadId, err)
+		}
+		if header.Cmp(d.TxInfo) == 0 {
+			return ev.PayloadId, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// decodeMessage decodes the message_ an adapter was handed, abi.encode(AMBMessage). The payload carrying message
+// has params abi.encode(ambIds, params) and its proof is computed; a proof message has the proof as params and nil
+// ambIds.
+func decodeMessage(payload []byte) (*big.Int, []uint8, common.Hash, error) {
+	m, err := payloads.DecodeMessage(payload)
+	if err != nil {
+		return nil, nil, common.Hash{}, err
+	}
+	if len(m.Params) == 32 {
+		return m.TxInfo, nil, common.BytesToHash(m.Params), nil
+	}
+	params, err := messageParamsArgs.Unpack(m.Params)
+	if err != nil {
+		return nil, nil, common.Hash{}, err
+	}
+	proof, err := payloads.Proof(m.TxInfo, params[1].([]byte))
+	if err != nil {
+		return nil, nil, common.Hash{}, err
+	}
+	return m.TxInfo, params[0].([]uint8), proof, nil
+}
+
+func mustType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
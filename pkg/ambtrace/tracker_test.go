@@ -0,0 +1,158 @@
+package ambtrace
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/ambtopup"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+	"github.com/superform-xyz/superform-core/pkg/payloads"
+)
+
+var (
+	testSuperRegistry = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testHyperlane     = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testMailbox       = common.HexToAddress("0x3333333333333333333333333333333333333333")
+	testTx            = common.HexToHash("0x01")
+)
+
+// fakeChain is a source chain whose SuperRegistry only registers Hyperlane (AMB id 6), like a chain without
+// some of the default adapters. Calls it does not expect panic through the nil Backend.
+type fakeChain struct {
+	Backend
+	receipt *types.Receipt
+}
+
+func (*fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (c *fakeChain) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	return c.receipt, nil
+}
+
+func (c *fakeChain) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	metadata := contracts.HyperlaneImplementationMetaData
+	if *call.To == testSuperRegistry {
+		metadata = contracts.SuperRegistryMetaData
+	}
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "ambAddresses":
+		if args[0].(uint8) == 6 {
+			return method.Outputs.Pack(testHyperlane)
+		}
+		return method.Outputs.Pack(common.Address{})
+	case "mailbox":
+		return method.Outputs.Pack(testMailbox)
+	case "superChainId":
+		if args[0].(uint32) != 8453 {
+			return nil, fmt.Errorf("unknown domain %d", args[0])
+		}
+		return method.Outputs.Pack(uint64(8453))
+	default:
+		return nil, fmt.Errorf("unexpected call %s", method.Name)
+	}
+}
+
+// hyperlaneDispatchLog is the Mailbox Dispatch of message_ sent by testHyperlane to domain 8453.
+func hyperlaneDispatchLog(t *testing.T, message []byte) *types.Log {
+	header := make([]byte, 77)
+	header[0] = 3
+	binary.BigEndian.PutUint32(header[1:5], 17)
+	binary.BigEndian.PutUint32(header[5:9], 10)
+	copy(header[9:41], common.BytesToHash(testHyperlane.Bytes()).Bytes())
+	binary.BigEndian.PutUint32(header[41:45], 8453)
+	data, err := bytesArgs.Pack(append(header, message...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Log{
+		Address: testMailbox,
+		Topics: []common.Hash{
+			hyperlaneDispatch,
+			common.BytesToHash(testHyperlane.Bytes()),
+			common.BigToHash(big.NewInt(8453)),
+			{},
+		},
+		Data:  data,
+		Index: 4,
+	}
+}
+
+func TestOriginPartiallyRegisteredChain(t *testing.T) {
+	txInfo := datalib.PackTxInfo(datalib.TxInfo{Multi: 1, RegistryID: 1, SrcSender: testSuperRegistry, SrcChainID: 10})
+	body := []byte{0x0a, 0x0b}
+	params, err := messageParamsArgs.Pack([]uint8{6}, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, err := abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "txInfo", Type: "uint256"},
+		{Name: "params", Type: "bytes"},
+	})}}.Pack(contracts.AMBMessage{TxInfo: txInfo, Params: params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := &fakeChain{receipt: &types.Receipt{BlockNumber: big.NewInt(100), Logs: []*types.Log{hyperlaneDispatchLog(t, message)}}}
+	tracker := NewTracker(map[uint64]Chain{10: {Backend: chain, SuperRegistry: testSuperRegistry, FromBlock: 1}}, nil)
+
+	for id := range ambtopup.DefaultAdapters {
+		_, err := tracker.endpoint(&bind.CallOpts{}, tracker.chains[10], id)
+		if id == 6 && err != nil {
+			t.Fatalf("AMB id 6: %v", err)
+		}
+		if id != 6 && !errors.Is(err, ErrUnknownAdapter) {
+			t.Fatalf("AMB id %d: err %v, want %v", id, err, ErrUnknownAdapter)
+		}
+	}
+
+	dispatches, err := tracker.Origin(context.Background(), 10, testTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := payloads.Proof(txInfo, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dispatches) != 1 {
+		t.Fatalf("%d dispatches, want 1", len(dispatches))
+	}
+	d := dispatches[0]
+	if d.DstChainID != 8453 || d.Proof != proof || d.TxInfo.Cmp(txInfo) != 0 || len(d.AMBIDs) != 1 || d.AMBIDs[0] != 6 {
+		t.Fatalf("got %+v", d)
+	}
+	if len(d.Messages) != 1 {
+		t.Fatalf("%d messages, want 1", len(d.Messages))
+	}
+	if m := d.Messages[0]; m.Adapter != ambtopup.Hyperlane || m.Proof || m.Nonce != 17 || m.LogIndex != 4 || m.Implementation != testHyperlane {
+		t.Fatalf("got %+v", m)
+	}
+
+	chain.receipt.Logs = nil
+	if _, err := tracker.Origin(context.Background(), 10, testTx); !errors.Is(err, ErrNoDispatch) {
+		t.Fatalf("err %v, want %v", err, ErrNoDispatch)
+	}
+}
@@ -0,0 +1,195 @@
+// Package approvals manages ERC1155A allowances on SuperPositions.
+//
+// SuperformRouter burns and SuperformRouterPlus transfers a user's SuperPositions as an operator, so before a
+// withdraw or rebalance the user must either approve the operator for all ids or hold a per-id allowance of at
+// least the amount moved. The package computes the smallest allowance increase covering an action, rebuilds
+// per-operator allowances from ApprovalForOne/ApprovalForAll events and plans revocations for stale operators.
+package approvals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Action is the SuperPositions call a Change performs.
+type Action uint8
+
+const (
+	// IncreaseAllowance calls increaseAllowanceForMany(operator, ids, amounts).
+	IncreaseAllowance Action = iota
+	// SetApproval calls setApprovalForMany(operator, ids, amounts), overwriting the allowances.
+	SetApproval
+	// RevokeAll calls setApprovalForAll(operator, false).
+	RevokeAll
+)
+
+func (a Action) String() string {
+	switch a {
+	case IncreaseAllowance:
+		return "increaseAllowanceForMany"
+	case SetApproval:
+		return "setApprovalForMany"
+	case RevokeAll:
+		return "setApprovalForAll"
+	default:
+		return fmt.Sprintf("Action(%d)", uint8(a))
+	}
+}
+
+// Change is one allowance transaction owner must send.
+type Change struct {
+	Action   Action
+	Operator common.Address
+	IDs      []*big.Int
+	Amounts  []*big.Int
+}
+
+// Requirement is an amount of a SuperPosition id an operator will move on the owner's behalf.
+type Requirement struct {
+	ID     *big.Int
+	Amount *big.Int
+}
+
+// Manager reads and changes SuperPositions allowances.
+type Manager struct {
+	positions *contracts.SuperPositions
+}
+
+// NewManager creates a Manager for the SuperPositions contract at superPositions.
+func NewManager(backend bind.ContractBackend, superPositions common.Address) (*Manager, error) {
+	positions, err := contracts.NewSuperPositions(superPositions, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{positions: positions}, nil
+}
+
+// Required returns the allowance changes owner needs before operator can move reqs. Requirements on the same id
+// are summed. The result is empty when operator is approved for all or every allowance already suffices;
+// otherwise it is a single IncreaseAllowance for the shortfall of each id.
+func (m *Manager) Required(ctx context.Context, owner, operator common.Address, reqs []Requirement) ([]Change, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	all, err := m.positions.IsApprovedForAll(opts, owner, operator)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		return nil, nil
+	}
+
+	ids, amounts := merge(reqs)
+	change := Change{Action: IncreaseAllowance, Operator: operator}
+	for i, id := range ids {
+		current, err := m.positions.Allowance(opts, owner, operator, id)
+		if err != nil {
+			return nil, fmt.Errorf("id %s: %w", id, err)
+		}
+		if current.Cmp(amounts[i]) >= 0 {
+			continue
+		}
+		change.IDs = append(change.IDs, id)
+		change.Amounts = append(change.Amounts, new(big.Int).Sub(amounts[i], current))
+	}
+	if len(change.IDs) == 0 {
+		return nil, nil
+	}
+	return []Change{change}, nil
+}
+
+// Apply sends change from opts.From.
+func (m *Manager) Apply(opts *bind.TransactOpts, change Change) (*types.Transaction, error) {
+	switch change.Action {
+	case IncreaseAllowance:
+		if len(change.IDs) == 1 {
+			return m.positions.IncreaseAllowance(opts, change.Operator, change.IDs[0], change.Amounts[0])
+		}
+		return m.positions.IncreaseAllowanceForMany(opts, change.Operator, change.IDs, change.Amounts)
+	case SetApproval:
+		if len(change.IDs) == 1 {
+			return m.positions.SetApprovalForOne(opts, change.Operator, change.IDs[0], change.Amounts[0])
+		}
+		return m.positions.SetApprovalForMany(opts, change.Operator, change.IDs, change.Amounts)
+	case RevokeAll:
+		return m.positions.SetApprovalForAll(opts, change.Operator, false)
+	default:
+		return nil, fmt.Errorf("approvals: unknown action %s", change.Action)
+	}
+}
+
+// merge sums requirements per id, returning ids in ascending order.
+func merge(reqs []Requirement) ([]*big.Int, []*big.Int) {
+	totals := make(map[string]*big.Int)
+	keys := make(map[string]*big.Int)
+	for _, r := range reqs {
+		k := r.ID.String()
+		if totals[k] == nil {
+			totals[k] = new(big.Int)
+			keys[k] = r.ID
+		}
+		totals[k].Add(totals[k], r.Amount)
+	}
+
+	ids := make([]*big.Int, 0, len(keys))
+	for _, id := range keys {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+
+	amounts := make([]*big.Int, len(ids))
+	for i, id := range ids {
+		amounts[i] = totals[id.String()]
+	}
+	return ids, amounts
+}
+
+// SingleVaultWithdraw returns what the router burns for a single vault withdraw.
+func SingleVaultWithdraw(sf contracts.SingleVaultSFData) []Requirement {
+	return []Requirement{{ID: sf.SuperformId, Amount: sf.Amount}}
+}
+
+// MultiVaultWithdraw returns what the router burns for a multi vault withdraw.
+func MultiVaultWithdraw(sf contracts.MultiVaultSFData) ([]Requirement, error) {
+	return zip(sf.SuperformIds, sf.Amounts)
+}
+
+// RebalanceSinglePosition returns what RouterPlus transfers in for a same chain single position rebalance.
+func RebalanceSinglePosition(args contracts.ISuperformRouterPlusRebalanceSinglePositionSyncArgs) []Requirement {
+	return []Requirement{{ID: args.Id, Amount: args.SharesToRedeem}}
+}
+
+// RebalanceMultiPositions returns what RouterPlus transfers in for a same chain multi position rebalance.
+func RebalanceMultiPositions(args contracts.ISuperformRouterPlusRebalanceMultiPositionsSyncArgs) ([]Requirement, error) {
+	return zip(args.Ids, args.SharesToRedeem)
+}
+
+// CrossChainRebalance returns what RouterPlus transfers in when starting a cross chain rebalance.
+func CrossChainRebalance(args contracts.ISuperformRouterPlusInitiateXChainRebalanceArgs) []Requirement {
+	return []Requirement{{ID: args.Id, Amount: args.SharesToRedeem}}
+}
+
+// CrossChainRebalanceMulti returns what RouterPlus transfers in when starting a multi position cross chain
+// rebalance.
+func CrossChainRebalanceMulti(args contracts.ISuperformRouterPlusInitiateXChainRebalanceMultiArgs) ([]Requirement, error) {
+	return zip(args.Ids, args.SharesToRedeem)
+}
+
+func zip(ids, amounts []*big.Int) ([]Requirement, error) {
+	if len(ids) != len(amounts) {
+		return nil, errors.New("approvals: ids and amounts length mismatch")
+	}
+	reqs := make([]Requirement, len(ids))
+	for i := range ids {
+		reqs[i] = Requirement{ID: ids[i], Amount: amounts[i]}
+	}
+	return reqs, nil
+}
@@ -0,0 +1,193 @@
+package approvals
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// OperatorAllowance is what one owner granted one operator.
+type OperatorAllowance struct {
+	// All is the setApprovalForAll flag.
+	All bool
+	// IDs holds the per-id allowances, keyed by decimal id.
+	IDs map[string]*big.Int
+}
+
+// Active reports whether the operator can still move any of the owner's positions.
+func (a *OperatorAllowance) Active() bool {
+	if a.All {
+		return true
+	}
+	for _, amount := range a.IDs {
+		if amount.Sign() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// State is the allowance book of every owner, indexed by owner then operator.
+type State struct {
+	Owners map[common.Address]map[common.Address]*OperatorAllowance
+	// Block is the last block folded into the state.
+	Block uint64
+}
+
+func newState() *State {
+	return &State{Owners: make(map[common.Address]map[common.Address]*OperatorAllowance)}
+}
+
+func (s *State) entry(owner, operator common.Address) *OperatorAllowance {
+	ops := s.Owners[owner]
+	if ops == nil {
+		ops = make(map[common.Address]*OperatorAllowance)
+		s.Owners[owner] = ops
+	}
+	a := ops[operator]
+	if a == nil {
+		a = &OperatorAllowance{IDs: make(map[string]*big.Int)}
+		ops[operator] = a
+	}
+	return a
+}
+
+// Operators returns the operators of owner that still hold an approval, sorted by address.
+func (s *State) Operators(owner common.Address) []common.Address {
+	var ops []common.Address
+	for op, a := range s.Owners[owner] {
+		if a.Active() {
+			ops = append(ops, op)
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Cmp(ops[j]) < 0 })
+	return ops
+}
+
+type approvalLog struct {
+	raw   types.Log
+	apply func(*State)
+}
+
+// Reconstruct replays ApprovalForOne and ApprovalForAll events from fromBlock in log order. Owners restricts the
+// replay to those owners; nil replays everyone. ApprovalForOne carries the resulting allowance, so the last event
+// per (owner, operator, id) wins.
+//
+// Allowance spent by transfers and burns is not always visible in events, so the reconstructed amounts are an
+// upper bound; call Refresh to replace them with the on-chain values.
+func (m *Manager) Reconstruct(ctx context.Context, fromBlock uint64, owners []common.Address) (*State, error) {
+	opts := &bind.FilterOpts{Start: fromBlock, Context: ctx}
+
+	var logs []approvalLog
+
+	one, err := m.positions.FilterApprovalForOne(opts, owners, nil)
+	if err != nil {
+		return nil, err
+	}
+	for one.Next() {
+		ev := one.Event
+		logs = append(logs, approvalLog{raw: ev.Raw, apply: func(s *State) {
+			s.entry(ev.Owner, ev.Spender).IDs[ev.Id.String()] = ev.Amount
+		}})
+	}
+	if err := one.Error(); err != nil {
+		one.Close()
+		return nil, err
+	}
+	one.Close()
+
+	all, err := m.positions.FilterApprovalForAll(opts, owners, nil)
+	if err != nil {
+		return nil, err
+	}
+	for all.Next() {
+		ev := all.Event
+		logs = append(logs, approvalLog{raw: ev.Raw, apply: func(s *State) {
+			s.entry(ev.Account, ev.Operator).All = ev.Approved
+		}})
+	}
+	if err := all.Error(); err != nil {
+		all.Close()
+		return nil, err
+	}
+	all.Close()
+
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].raw.BlockNumber != logs[j].raw.BlockNumber {
+			return logs[i].raw.BlockNumber < logs[j].raw.BlockNumber
+		}
+		return logs[i].raw.Index < logs[j].raw.Index
+	})
+
+	state := newState()
+	for _, l := range logs {
+		l.apply(state)
+		state.Block = l.raw.BlockNumber
+	}
+	return state, nil
+}
+
+// Refresh overwrites every reconstructed allowance and approval flag with the current on-chain value.
+func (m *Manager) Refresh(ctx context.Context, state *State) error {
+	opts := &bind.CallOpts{Context: ctx}
+	for owner, ops := range state.Owners {
+		for operator, a := range ops {
+			all, err := m.positions.IsApprovedForAll(opts, owner, operator)
+			if err != nil {
+				return err
+			}
+			a.All = all
+			for key := range a.IDs {
+				id, _ := new(big.Int).SetString(key, 10)
+				amount, err := m.positions.Allowance(opts, owner, operator, id)
+				if err != nil {
+					return err
+				}
+				a.IDs[key] = amount
+			}
+		}
+	}
+	return nil
+}
+
+// RevokePlan returns the changes owner must send to drop every operator not in keep: a RevokeAll for operators
+// approved for all ids and a SetApproval to zero for any remaining per-id allowance.
+func RevokePlan(state *State, owner common.Address, keep []common.Address) []Change {
+	kept := make(map[common.Address]bool, len(keep))
+	for _, op := range keep {
+		kept[op] = true
+	}
+
+	var plan []Change
+	for _, operator := range state.Operators(owner) {
+		if kept[operator] {
+			continue
+		}
+		a := state.Owners[owner][operator]
+		if a.All {
+			plan = append(plan, Change{Action: RevokeAll, Operator: operator})
+		}
+
+		var ids []*big.Int
+		for key, amount := range a.IDs {
+			if amount.Sign() > 0 {
+				id, _ := new(big.Int).SetString(key, 10)
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+		zeros := make([]*big.Int, len(ids))
+		for i := range zeros {
+			zeros[i] = new(big.Int)
+		}
+		plan = append(plan, Change{Action: SetApproval, Operator: operator, IDs: ids, Amounts: zeros})
+	}
+	return plan
+}
@@ -0,0 +1,253 @@
+// Package async tracks ERC7540 deposit and redeem requests made through AsyncStateRegistry.
+//
+// Deposits into and redeems from an ERC7540Form are requests on the vault: the form records a RequestConfig per
+// user and superform in AsyncStateRegistry (UpdatedRequestsConfig) and the vault later moves the request from
+// pending to claimable. Claiming is done by an ASYNC_STATE_REGISTRY_PROCESSOR through claimAvailableDeposits and
+// claimAvailableRedeem. Withdrawals whose txData must be refreshed before a synchronous redeem are stored separately
+// as SyncWithdrawTxDataPayloads.
+package async
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+// Status mirrors AsyncStatus.
+type Status uint8
+
+const (
+	Unavailable Status = iota
+	Pending
+	Processed
+)
+
+func (s Status) String() string {
+	switch s {
+	case Unavailable:
+		return "UNAVAILABLE"
+	case Pending:
+		return "PENDING"
+	case Processed:
+		return "PROCESSED"
+	default:
+		return fmt.Sprintf("AsyncStatus(%d)", uint8(s))
+	}
+}
+
+// Key identifies a RequestConfig.
+type Key struct {
+	User        common.Address
+	SuperformID string
+}
+
+func newKey(user common.Address, superformID *big.Int) Key {
+	return Key{User: user, SuperformID: superformID.String()}
+}
+
+// Request is the stored RequestConfig of a user on a superform together with the vault's view of it.
+type Request struct {
+	User        common.Address
+	SuperformID *big.Int
+	Superform   common.Address
+	Config      contracts.RequestConfig
+
+	PendingDeposit   *big.Int
+	ClaimableDeposit *big.Int
+	PendingRedeem    *big.Int
+	ClaimableRedeem  *big.Int
+}
+
+// XChain reports whether the request came from another chain, in which case claiming a deposit dispatches an
+// acknowledgement back to Config.CurrentSrcChainId.
+func (r Request) XChain() bool {
+	return r.Config.IsXChain == 1
+}
+
+// Claimable reports whether either side of the request can be claimed.
+func (r Request) Claimable() bool {
+	return r.ClaimableDeposit.Sign() > 0 || r.ClaimableRedeem.Sign() > 0
+}
+
+// Outstanding reports whether anything is pending or claimable.
+func (r Request) Outstanding() bool {
+	return r.Claimable() || r.PendingDeposit.Sign() > 0 || r.PendingRedeem.Sign() > 0
+}
+
+// SyncWithdrawal is a stored SyncWithdrawTxDataPayload.
+type SyncWithdrawal struct {
+	ID *big.Int
+	contracts.SyncWithdrawTxDataPayload
+}
+
+// Filter narrows Requests down; zero fields match everything.
+type Filter struct {
+	User        common.Address
+	SuperformID *big.Int
+}
+
+func (f Filter) match(k Key) bool {
+	if f.User != (common.Address{}) && f.User != k.User {
+		return false
+	}
+	return f.SuperformID == nil || f.SuperformID.String() == k.SuperformID
+}
+
+// Backend is the chain access the tracker and keeper need.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Tracker follows the requests stored in the AsyncStateRegistry of one chain.
+type Tracker struct {
+	backend  Backend
+	address  common.Address
+	registry *contracts.AsyncStateRegistry
+
+	mu   sync.Mutex
+	next uint64
+	keys map[Key]*big.Int
+}
+
+// NewTracker creates a Tracker for the AsyncStateRegistry at address, indexing requests from fromBlock, typically
+// its deployment block.
+func NewTracker(backend Backend, address common.Address, fromBlock uint64) (*Tracker, error) {
+	registry, err := contracts.NewAsyncStateRegistry(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		backend:  backend,
+		address:  address,
+		registry: registry,
+		next:     fromBlock,
+		keys:     make(map[Key]*big.Int),
+	}, nil
+}
+
+// Sync indexes the (user, superform) pairs whose request config was updated since the previous Sync.
+func (t *Tracker) Sync(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	head, err := t.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	to := head.Number.Uint64()
+	if to < t.next {
+		return nil
+	}
+
+	it, err := t.registry.FilterUpdatedRequestsConfig(&bind.FilterOpts{Start: t.next, End: &to, Context: ctx}, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		t.keys[newKey(it.Event.User, it.Event.SuperformId)] = it.Event.SuperformId
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	t.next = to + 1
+	return nil
+}
+
+// Requests syncs the tracker and returns the current state of every known request matching filter, ordered by user
+// then superform id. Requests with nothing pending or claimable are included; use Outstanding to drop them.
+func (t *Tracker) Requests(ctx context.Context, filter Filter) ([]Request, error) {
+	if err := t.Sync(ctx); err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	keys := make([]Key, 0, len(t.keys))
+	ids := make(map[Key]*big.Int, len(t.keys))
+	for k, id := range t.keys {
+		if filter.match(k) {
+			keys = append(keys, k)
+			ids[k] = id
+		}
+	}
+	t.mu.Unlock()
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].User != keys[j].User {
+			return keys[i].User.Cmp(keys[j].User) < 0
+		}
+		return ids[keys[i]].Cmp(ids[keys[j]]) < 0
+	})
+
+	out := make([]Request, 0, len(keys))
+	for _, k := range keys {
+		r, err := t.Request(ctx, k.User, ids[k])
+		if err != nil {
+			return nil, fmt.Errorf("user %s superform %s: %w", k.User, k.SuperformID, err)
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// Request reads the stored config of user on superformID and asks the form for the pending and claimable amounts of
+// its request id.
+func (t *Tracker) Request(ctx context.Context, user common.Address, superformID *big.Int) (Request, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	sf, err := datalib.GetSuperform(superformID)
+	if err != nil {
+		return Request{}, err
+	}
+	config, err := t.registry.GetRequestConfig(opts, user, superformID)
+	if err != nil {
+		return Request{}, err
+	}
+	form, err := contracts.NewERC7540FormCaller(sf.Superform, t.backend)
+	if err != nil {
+		return Request{}, err
+	}
+
+	r := Request{User: user, SuperformID: superformID, Superform: sf.Superform, Config: config}
+	if r.PendingDeposit, err = form.GetPendingDepositRequest(opts, config.RequestId, user); err != nil {
+		return Request{}, err
+	}
+	if r.ClaimableDeposit, err = form.GetClaimableDepositRequest(opts, config.RequestId, user); err != nil {
+		return Request{}, err
+	}
+	if r.PendingRedeem, err = form.GetPendingRedeemRequest(opts, config.RequestId, user); err != nil {
+		return Request{}, err
+	}
+	if r.ClaimableRedeem, err = form.GetClaimableRedeemRequest(opts, config.RequestId, user); err != nil {
+		return Request{}, err
+	}
+	return r, nil
+}
+
+// SyncWithdrawals returns the stored sync withdraw txData payloads still waiting for
+// processSyncWithdrawWithUpdatedTxData. Processed payloads are deleted on chain, so only PENDING ones remain.
+func (t *Tracker) SyncWithdrawals(ctx context.Context) ([]SyncWithdrawal, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	last, err := t.registry.SyncWithdrawTxDataPayloadCounter(opts)
+	if err != nil {
+		return nil, err
+	}
+	var out []SyncWithdrawal
+	for id := big.NewInt(1); id.Cmp(last) <= 0; id.Add(id, common.Big1) {
+		p, err := t.registry.GetSyncWithdrawTxDataPayload(opts, id)
+		if err != nil {
+			return nil, fmt.Errorf("sync withdrawal %s: %w", id, err)
+		}
+		if Status(p.Status) == Pending {
+			out = append(out, SyncWithdrawal{ID: new(big.Int).Set(id), SyncWithdrawTxDataPayload: p})
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,229 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+var (
+	// ErrReverted is returned when a claim transaction is mined but reverted.
+	ErrReverted = errors.New("async: claim reverted")
+	// ErrClaimFailed is returned when a deposit claim is mined but the vault minted no shares; the registry emits
+	// FailedDepositClaim and the request stays with the vault.
+	ErrClaimFailed = errors.New("async: deposit claim minted no shares")
+)
+
+// Claim is the side of a request a keeper finalizes.
+type Claim uint8
+
+const (
+	Deposit Claim = iota
+	Redeem
+)
+
+func (c Claim) String() string {
+	switch c {
+	case Deposit:
+		return "deposit"
+	case Redeem:
+		return "redeem"
+	default:
+		return fmt.Sprintf("Claim(%d)", uint8(c))
+	}
+}
+
+// Result is the outcome of one claim.
+type Result struct {
+	Request Request
+	Claim   Claim
+	// Fee is the msg.value sent to pay for the acknowledgement of a cross chain deposit.
+	Fee    *big.Int
+	TxHash common.Hash
+	Err    error
+}
+
+var (
+	ambMessageArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "txInfo", Type: "uint256"},
+		{Name: "params", Type: "bytes"},
+	})}}
+	returnSingleDataArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "payloadId", Type: "uint256"},
+		{Name: "superformId", Type: "uint256"},
+		{Name: "amount", Type: "uint256"},
+	})}}
+)
+
+func mustType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// Keeper claims the requests ERC7540 vaults have fulfilled. Its transactor must hold
+// ASYNC_STATE_REGISTRY_PROCESSOR_ROLE.
+type Keeper struct {
+	tracker  *Tracker
+	registry *contracts.AsyncStateRegistry
+	payments *contracts.PaymentHelperCaller
+}
+
+// NewKeeper creates a Keeper over tracker, quoting acknowledgement fees from the PaymentHelper at paymentHelper.
+func NewKeeper(tracker *Tracker, paymentHelper common.Address) (*Keeper, error) {
+	payments, err := contracts.NewPaymentHelperCaller(paymentHelper, tracker.backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Keeper{tracker: tracker, registry: tracker.registry, payments: payments}, nil
+}
+
+// Run claims every claimable request matching filter and waits for the claims to be mined. Failed claims are
+// reported in their Result and do not stop the run.
+func (k *Keeper) Run(opts *bind.TransactOpts, filter Filter) ([]Result, error) {
+	requests, err := k.tracker.Requests(opts.Context, filter)
+	if err != nil {
+		return nil, err
+	}
+	var results []Result
+	for _, r := range requests {
+		results = append(results, k.Finalize(opts, r)...)
+	}
+	return results, nil
+}
+
+// Finalize claims whatever is claimable on r: its deposit, its redeem or both.
+func (k *Keeper) Finalize(opts *bind.TransactOpts, r Request) []Result {
+	var results []Result
+	if r.ClaimableDeposit.Sign() > 0 {
+		results = append(results, k.claim(opts, r, Deposit))
+	}
+	if r.ClaimableRedeem.Sign() > 0 {
+		results = append(results, k.claim(opts, r, Redeem))
+	}
+	return results
+}
+
+func (k *Keeper) claim(opts *bind.TransactOpts, r Request, c Claim) Result {
+	res := Result{Request: r, Claim: c, Fee: new(big.Int)}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var (
+		tx  *types.Transaction
+		err error
+	)
+	switch c {
+	case Deposit:
+		if r.XChain() && !r.Config.Retain4626 {
+			if res.Fee, err = k.AckFee(ctx, r); err != nil {
+				res.Err = fmt.Errorf("ack fee: %w", err)
+				return res
+			}
+		}
+		o := *opts
+		o.Value = res.Fee
+		tx, err = k.registry.ClaimAvailableDeposits(&o, r.User, r.SuperformID)
+	case Redeem:
+		// Empty updatedTxData keeps the liquidity request stored with the request config.
+		tx, err = k.registry.ClaimAvailableRedeem(opts, r.User, r.SuperformID, nil)
+	}
+	if err != nil {
+		res.Err = err
+		log.Warn("Async claim failed", "claim", c, "user", r.User, "superformId", r.SuperformID, "err", err)
+		return res
+	}
+	res.TxHash = tx.Hash()
+
+	receipt, err := bind.WaitMined(ctx, k.tracker.backend, tx)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		res.Err = fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+		log.Warn("Async claim reverted", "claim", c, "user", r.User, "superformId", r.SuperformID, "tx", tx.Hash())
+		return res
+	}
+	if c == Deposit && k.failedDeposit(receipt) {
+		res.Err = fmt.Errorf("%w: %s", ErrClaimFailed, tx.Hash())
+		log.Warn("Async deposit claim failed", "user", r.User, "superformId", r.SuperformID, "requestId", r.Config.RequestId, "tx", tx.Hash())
+		return res
+	}
+	log.Info("Claimed async request", "claim", c, "user", r.User, "superformId", r.SuperformID, "requestId", r.Config.RequestId, "fee", res.Fee, "tx", tx.Hash())
+	return res
+}
+
+// failedDeposit reports whether receipt carries the registry's FailedDepositClaim.
+func (k *Keeper) failedDeposit(receipt *types.Receipt) bool {
+	for _, l := range receipt.Logs {
+		if l.Address != k.tracker.address {
+			continue
+		}
+		if _, err := k.registry.ParseFailedDepositClaim(*l); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// AckFee quotes the msg.value claimAvailableDeposits needs to acknowledge a cross chain deposit back to the request's
+// source chain. The acknowledgement carries the minted shares, which are only known once claimed; the claimable
+// assets stand in for them as the message size, and so the fee, does not depend on the amount.
+func (k *Keeper) AckFee(ctx context.Context, r Request) (*big.Int, error) {
+	form, err := contracts.NewERC7540FormCaller(r.Superform, k.tracker.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	registryID, err := form.GetStateRegistryId(opts)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := datalib.GetDestinationChain(r.SuperformID)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := returnSingleDataArgs.Pack(struct {
+		PayloadId   *big.Int
+		SuperformId *big.Int
+		Amount      *big.Int
+	}{r.Config.CurrentReturnDataPayloadId, r.SuperformID, r.ClaimableDeposit})
+	if err != nil {
+		return nil, err
+	}
+	message, err := ambMessageArgs.Pack(contracts.AMBMessage{
+		TxInfo: datalib.PackTxInfo(datalib.TxInfo{
+			TxType:       0, // DEPOSIT
+			CallbackType: 1, // RETURN
+			RegistryID:   registryID,
+			SrcSender:    r.User,
+			SrcChainID:   chainID,
+		}),
+		Params: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := k.payments.CalculateAMBData(opts, r.Config.CurrentSrcChainId, r.Config.AmbIds, message)
+	if err != nil {
+		return nil, err
+	}
+	return quote.TotalFees, nil
+}
@@ -0,0 +1,82 @@
+// Package broadcast handles the payloads Broadcastable contracts (SuperformFactory, SuperPositions, SuperRBAC) hand
+// to BroadcastRegistry for delivery to every connected chain: the AMB extra data of the broadcasting call, the
+// BroadcastMessage each destination stores, and the tracking of a broadcast until every destination processed it.
+package broadcast
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var (
+	extraDataArgs = abi.Arguments{
+		{Type: mustType("uint8")},
+		{Type: mustType("bytes")},
+	}
+	broadcastParamsArgs = abi.Arguments{
+		{Type: mustType("uint256")},
+		{Type: mustType("bytes")},
+	}
+)
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// ExtraData is the AMB override decoded by Broadcastable._broadcast:
+// abi.encode(uint8 ambId, abi.encode(uint256 gasFee, bytes extraData)).
+//
+// GasFee is forwarded as msg.value to BroadcastRegistry.broadcastPayload, so the calling transaction must carry at
+// least GasFee; anything above it is sent to PayMaster.
+type ExtraData struct {
+	AmbID        uint8
+	GasFee       *big.Int
+	AMBExtraData []byte
+}
+
+// DecodeExtraData decodes the extraData_ argument of a broadcasting call.
+func DecodeExtraData(data []byte) (ExtraData, error) {
+	outer, err := extraDataArgs.Unpack(data)
+	if err != nil {
+		return ExtraData{}, err
+	}
+	inner, err := broadcastParamsArgs.Unpack(outer[1].([]byte))
+	if err != nil {
+		return ExtraData{}, err
+	}
+	return ExtraData{
+		AmbID:        outer[0].(uint8),
+		GasFee:       inner[0].(*big.Int),
+		AMBExtraData: inner[1].([]byte),
+	}, nil
+}
+
+// Encode encodes e as the extraData_ argument of a broadcasting call.
+func (e ExtraData) Encode() ([]byte, error) {
+	gasFee := e.GasFee
+	if gasFee == nil {
+		gasFee = new(big.Int)
+	}
+	ambExtraData := e.AMBExtraData
+	if ambExtraData == nil {
+		ambExtraData = []byte{}
+	}
+	params, err := broadcastParamsArgs.Pack(gasFee, ambExtraData)
+	if err != nil {
+		return nil, err
+	}
+	return extraDataArgs.Pack(e.AmbID, params)
+}
+
+// Covers reports whether value pays for the broadcast.
+func (e ExtraData) Covers(value *big.Int) bool {
+	if e.GasFee == nil || e.GasFee.Sign() == 0 {
+		return true
+	}
+	return value != nil && value.Cmp(e.GasFee) >= 0
+}
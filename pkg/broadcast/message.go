@@ -0,0 +1,208 @@
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Targets are the SuperRegistry ids, as raw strings, of the contracts BroadcastRegistry.processPayload hands a
+// message to.
+const (
+	TargetFactory        = "SUPERFORM_FACTORY"
+	TargetSuperPositions = "SUPER_POSITIONS"
+	TargetSuperRBAC      = "SUPER_RBAC"
+)
+
+// Message types, as set by the broadcasting contracts.
+var (
+	// SyncImplementationStatus is broadcast by SuperformFactory.changeFormImplementationPauseStatus.
+	SyncImplementationStatus = crypto.Keccak256Hash([]byte("SYNC_IMPLEMENTATION_STATUS"))
+	// DeployNewAERC20 is broadcast by SuperPositions.registerAERC20.
+	DeployNewAERC20 = crypto.Keccak256Hash([]byte("DEPLOY_NEW_AERC20"))
+	// SyncRevoke is broadcast by SuperRBAC.revokeRoleSuperBroadcast.
+	SyncRevoke = crypto.Keccak256Hash([]byte("SYNC_REVOKE"))
+)
+
+var messageTypeNames = map[common.Hash]string{
+	SyncImplementationStatus: "SYNC_IMPLEMENTATION_STATUS",
+	DeployNewAERC20:          "DEPLOY_NEW_AERC20",
+	SyncRevoke:               "SYNC_REVOKE",
+}
+
+// ErrMessageType is returned when a message is decoded as a type it does not carry.
+var ErrMessageType = errors.New("broadcast: unexpected message type")
+
+var (
+	messageArgs = abi.Arguments{
+		{Type: mustTupleType([]abi.ArgumentMarshaling{
+			{Name: "target", Type: "bytes"},
+			{Name: "messageType", Type: "bytes32"},
+			{Name: "message", Type: "bytes"},
+		})},
+	}
+	implementationStatusArgs = abi.Arguments{
+		{Type: mustType("uint64")},
+		{Type: mustType("uint256")},
+		{Type: mustType("uint32")},
+		{Type: mustType("uint8")},
+	}
+	aERC20RegistrationArgs = abi.Arguments{
+		{Type: mustType("uint64")},
+		{Type: mustType("uint256")},
+		{Type: mustType("uint256")},
+		{Type: mustType("string")},
+		{Type: mustType("string")},
+		{Type: mustType("uint8")},
+	}
+	roleRevocationArgs = abi.Arguments{
+		{Type: mustType("uint256")},
+		{Type: mustType("bytes32")},
+		{Type: mustType("bytes32")},
+	}
+)
+
+func mustTupleType(components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType("tuple", "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// Message is a decoded BroadcastMessage, the payload BroadcastRegistry stores on every destination and passes to
+// the target's stateSyncBroadcast.
+type Message struct {
+	// Target is the SuperRegistry id of the receiving contract, e.g. TargetFactory.
+	Target string
+	Type   common.Hash
+	// Data is the type specific message, see ImplementationStatus, AERC20Registration and RoleRevocation.
+	Data []byte
+}
+
+// broadcastMessage mirrors the BroadcastMessage struct for abi encoding.
+type broadcastMessage struct {
+	Target      []byte
+	MessageType [32]byte
+	Message     []byte
+}
+
+// DecodeMessage decodes abi.encode(BroadcastMessage), e.g. the data_ argument of stateSyncBroadcast or a
+// BroadcastRegistry payload.
+func DecodeMessage(data []byte) (Message, error) {
+	out, err := messageArgs.Unpack(data)
+	if err != nil {
+		return Message{}, fmt.Errorf("broadcast: message: %w", err)
+	}
+	m := *abi.ConvertType(out[0], new(broadcastMessage)).(*broadcastMessage)
+	return Message{Target: string(m.Target), Type: m.MessageType, Data: m.Message}, nil
+}
+
+// Encode encodes m as abi.encode(BroadcastMessage).
+func (m Message) Encode() ([]byte, error) {
+	data := m.Data
+	if data == nil {
+		data = []byte{}
+	}
+	return messageArgs.Pack(broadcastMessage{Target: []byte(m.Target), MessageType: m.Type, Message: data})
+}
+
+// TypeName returns the name of m's type, or its hash when unknown.
+func (m Message) TypeName() string {
+	if name, ok := messageTypeNames[m.Type]; ok {
+		return name
+	}
+	return m.Type.Hex()
+}
+
+func (m Message) String() string {
+	return fmt.Sprintf("%s(%s)", m.TypeName(), m.Target)
+}
+
+func (m Message) expect(typ common.Hash, args abi.Arguments) ([]interface{}, error) {
+	if m.Type != typ {
+		return nil, fmt.Errorf("%w: %s, want %s", ErrMessageType, m.TypeName(), messageTypeNames[typ])
+	}
+	out, err := args.Unpack(m.Data)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast: %s: %w", messageTypeNames[typ], err)
+	}
+	return out, nil
+}
+
+// ImplementationStatus is a SYNC_IMPLEMENTATION_STATUS message: a form implementation pause status change.
+type ImplementationStatus struct {
+	SrcChainID uint64
+	// Counter is the factory's xChainPayloadCounter, unique per source chain.
+	Counter              *big.Int
+	FormImplementationID uint32
+	// Status is an ISuperformFactory.PauseStatus.
+	Status uint8
+}
+
+// ImplementationStatus decodes m as a SYNC_IMPLEMENTATION_STATUS message.
+func (m Message) ImplementationStatus() (ImplementationStatus, error) {
+	out, err := m.expect(SyncImplementationStatus, implementationStatusArgs)
+	if err != nil {
+		return ImplementationStatus{}, err
+	}
+	return ImplementationStatus{
+		SrcChainID:           out[0].(uint64),
+		Counter:              out[1].(*big.Int),
+		FormImplementationID: out[2].(uint32),
+		Status:               out[3].(uint8),
+	}, nil
+}
+
+// AERC20Registration is a DEPLOY_NEW_AERC20 message: an aERC20 token to deploy for a superform.
+type AERC20Registration struct {
+	SrcChainID uint64
+	// Counter is the SuperPositions xChainPayloadCounter, unique per source chain.
+	Counter     *big.Int
+	SuperformID *big.Int
+	Name        string
+	Symbol      string
+	Decimals    uint8
+}
+
+// AERC20Registration decodes m as a DEPLOY_NEW_AERC20 message.
+func (m Message) AERC20Registration() (AERC20Registration, error) {
+	out, err := m.expect(DeployNewAERC20, aERC20RegistrationArgs)
+	if err != nil {
+		return AERC20Registration{}, err
+	}
+	return AERC20Registration{
+		SrcChainID:  out[0].(uint64),
+		Counter:     out[1].(*big.Int),
+		SuperformID: out[2].(*big.Int),
+		Name:        out[3].(string),
+		Symbol:      out[4].(string),
+		Decimals:    out[5].(uint8),
+	}, nil
+}
+
+// RoleRevocation is a SYNC_REVOKE message: a role to revoke from the address registered under SuperRegistryID.
+// Unlike the other messages it does not carry its source chain.
+type RoleRevocation struct {
+	// Counter is the SuperRBAC xChainPayloadCounter, unique per source chain.
+	Counter         *big.Int
+	Role            common.Hash
+	SuperRegistryID common.Hash
+}
+
+// RoleRevocation decodes m as a SYNC_REVOKE message.
+func (m Message) RoleRevocation() (RoleRevocation, error) {
+	out, err := m.expect(SyncRevoke, roleRevocationArgs)
+	if err != nil {
+		return RoleRevocation{}, err
+	}
+	return RoleRevocation{
+		Counter:         out[0].(*big.Int),
+		Role:            out[1].([32]byte),
+		SuperRegistryID: out[2].([32]byte),
+	}, nil
+}
@@ -0,0 +1,309 @@
+package broadcast
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrNotBroadcast is returned when an origin transaction did not broadcast through BroadcastRegistry.
+var ErrNotBroadcast = errors.New("broadcast: transaction did not broadcast")
+
+// DefaultBlockRange is the number of blocks the PayloadReceived search queries logs for at once unless the Chain
+// sets another.
+const DefaultBlockRange = 5000
+
+var (
+	// logMessagePublished is the Wormhole core event WormholeSRImplementation.broadcastPayload publishes the
+	// message through; it carries the exact bytes stored by every destination BroadcastRegistry.
+	logMessagePublished     = crypto.Keccak256Hash([]byte("LogMessagePublished(address,uint64,uint32,bytes,uint8)"))
+	logMessagePublishedArgs = abi.Arguments{
+		{Type: mustType("uint64")},
+		{Type: mustType("uint32")},
+		{Type: mustType("bytes")},
+		{Type: mustType("uint8")},
+	}
+)
+
+// DeliveryStatus is how far a broadcast got on a destination chain.
+type DeliveryStatus uint8
+
+const (
+	// Missing means the destination BroadcastRegistry never received the payload.
+	Missing DeliveryStatus = iota
+	// Stored means the payload was received but processPayload was not called, so the target did not sync.
+	Stored
+	// Processed means the payload was handed to the target's stateSyncBroadcast.
+	Processed
+)
+
+func (s DeliveryStatus) String() string {
+	switch s {
+	case Missing:
+		return "MISSING"
+	case Stored:
+		return "STORED"
+	case Processed:
+		return "PROCESSED"
+	default:
+		return fmt.Sprintf("DeliveryStatus(%d)", uint8(s))
+	}
+}
+
+// Backend is the chain access the tracker needs.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Chain is one chain followed by the tracker.
+type Chain struct {
+	Backend           Backend
+	BroadcastRegistry common.Address
+	// FromBlock bounds the PayloadReceived search, typically the registry's deployment block.
+	FromBlock uint64
+	// BlockRange is the number of blocks queried for logs at once; zero uses DefaultBlockRange.
+	BlockRange uint64
+}
+
+// Broadcast is a message sent through BroadcastRegistry on its origin chain.
+type Broadcast struct {
+	SrcChainID uint64
+	TxHash     common.Hash
+	Block      uint64
+	// Payload is abi.encode(BroadcastMessage), as stored by the destination registries.
+	Payload []byte
+	Message Message
+}
+
+// Delivery is the state of a broadcast on one destination chain.
+type Delivery struct {
+	ChainID uint64
+	Status  DeliveryStatus
+	// PayloadID, Block and TxHash locate the PayloadReceived event; they are unset when Missing.
+	PayloadID *big.Int
+	Block     uint64
+	TxHash    common.Hash
+}
+
+// Trace is a broadcast followed to every destination chain.
+type Trace struct {
+	Broadcast  Broadcast
+	Deliveries []Delivery
+}
+
+// Unsynced returns the destination chains the broadcast was not processed on.
+func (t Trace) Unsynced() []uint64 {
+	var out []uint64
+	for _, d := range t.Deliveries {
+		if d.Status != Processed {
+			out = append(out, d.ChainID)
+		}
+	}
+	return out
+}
+
+// Synced reports whether the broadcast was processed on every destination chain.
+func (t Trace) Synced() bool {
+	return len(t.Unsynced()) == 0
+}
+
+// Tracker follows broadcasts from their origin chain to their execution on every other chain.
+type Tracker struct {
+	chains map[uint64]Chain
+}
+
+// NewTracker creates a Tracker over chains keyed by chain id. Every chain other than a broadcast's origin is
+// expected to receive it.
+func NewTracker(chains map[uint64]Chain) *Tracker {
+	return &Tracker{chains: chains}
+}
+
+func (t *Tracker) chain(chainID uint64) (Chain, error) {
+	c, ok := t.chains[chainID]
+	if !ok {
+		return Chain{}, fmt.Errorf("broadcast: unknown chain %d", chainID)
+	}
+	return c, nil
+}
+
+// Origin returns the broadcasts sent by transaction txHash on chainID, in log order. It returns ErrNotBroadcast
+// when the transaction did not go through the chain's BroadcastRegistry.
+func (t *Tracker) Origin(ctx context.Context, chainID uint64, txHash common.Hash) ([]Broadcast, error) {
+	c, err := t.chain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := c.Backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	registry, err := contracts.NewBroadcastRegistryFilterer(c.BroadcastRegistry, c.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	var sent bool
+	for _, l := range receipt.Logs {
+		if l.Address != c.BroadcastRegistry {
+			continue
+		}
+		if _, err := registry.ParsePayloadSent(*l); err == nil {
+			sent = true
+			break
+		}
+	}
+	if !sent {
+		return nil, fmt.Errorf("%w: %s on chain %d", ErrNotBroadcast, txHash, chainID)
+	}
+
+	var out []Broadcast
+	for _, l := range receipt.Logs {
+		if len(l.Topics) == 0 || l.Topics[0] != logMessagePublished {
+			continue
+		}
+		fields, err := logMessagePublishedArgs.Unpack(l.Data)
+		if err != nil {
+			continue
+		}
+		payload := fields[2].([]byte)
+		m, err := DecodeMessage(payload)
+		if err != nil {
+			continue
+		}
+		out = append(out, Broadcast{
+			SrcChainID: chainID,
+			TxHash:     txHash,
+			Block:      receipt.BlockNumber.Uint64(),
+			Payload:    payload,
+			Message:    m,
+		})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%w: no broadcast message in %s on chain %d", ErrNotBroadcast, txHash, chainID)
+	}
+	return out, nil
+}
+
+// Track follows b to every other chain, in chain id order, and logs the chains where it was not processed.
+func (t *Tracker) Track(ctx context.Context, b Broadcast) (Trace, error) {
+	ids := make([]uint64, 0, len(t.chains))
+	for id := range t.chains {
+		if id != b.SrcChainID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	trace := Trace{Broadcast: b}
+	for _, id := range ids {
+		d, err := t.Delivery(ctx, id, b)
+		if err != nil {
+			return trace, err
+		}
+		if d.Status != Processed {
+			log.Warn("Broadcast not synced", "message", b.Message, "srcChainId", b.SrcChainID, "tx", b.TxHash, "chainId", id, "status", d.Status, "payloadId", d.PayloadID)
+		}
+		trace.Deliveries = append(trace.Deliveries, d)
+	}
+	log.Info("Tracked broadcast", "message", b.Message, "srcChainId", b.SrcChainID, "tx", b.TxHash, "destinations", len(ids), "unsynced", len(trace.Unsynced()))
+	return trace, nil
+}
+
+// TrackTx follows every broadcast sent by txHash on chainID.
+func (t *Tracker) TrackTx(ctx context.Context, chainID uint64, txHash common.Hash) ([]Trace, error) {
+	broadcasts, err := t.Origin(ctx, chainID, txHash)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Trace, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		trace, err := t.Track(ctx, b)
+		if err != nil {
+			return out, err
+		}
+		out = append(out, trace)
+	}
+	return out, nil
+}
+
+// Delivery looks b up among the payloads chainID's BroadcastRegistry received from b's origin chain.
+func (t *Tracker) Delivery(ctx context.Context, chainID uint64, b Broadcast) (Delivery, error) {
+	c, err := t.chain(chainID)
+	if err != nil {
+		return Delivery{}, err
+	}
+	registry, err := contracts.NewBroadcastRegistry(c.BroadcastRegistry, c.Backend)
+	if err != nil {
+		return Delivery{}, err
+	}
+	head, err := c.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return Delivery{}, err
+	}
+	to := head.Number.Uint64()
+	blockRange := c.BlockRange
+	if blockRange == 0 {
+		blockRange = DefaultBlockRange
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	seen := make(map[string]bool)
+	for from := c.FromBlock; from <= to; from += blockRange {
+		end := min(from+blockRange-1, to)
+		received, err := receivedFrom(ctx, registry, from, end, b.SrcChainID)
+		if err != nil {
+			return Delivery{}, fmt.Errorf("broadcast: chain %d: logs %d-%d: %w", chainID, from, end, err)
+		}
+		for _, ev := range received {
+			// Narrow on the indexed fields before paying for a payload call per event.
+			if ev.Raw.Address != c.BroadcastRegistry || ev.SrcChainId != b.SrcChainID || seen[ev.PayloadId.String()] {
+				continue
+			}
+			seen[ev.PayloadId.String()] = true
+			payload, err := registry.Payload(opts, ev.PayloadId)
+			if err != nil {
+				return Delivery{}, fmt.Errorf("broadcast: chain %d: payload %s: %w", chainID, ev.PayloadId, err)
+			}
+			if !bytes.Equal(payload, b.Payload) {
+				continue
+			}
+			state, err := registry.PayloadTracking(opts, ev.PayloadId)
+			if err != nil {
+				return Delivery{}, fmt.Errorf("broadcast: chain %d: payloadTracking %s: %w", chainID, ev.PayloadId, err)
+			}
+			d := Delivery{ChainID: chainID, Status: Stored, PayloadID: ev.PayloadId, Block: ev.Raw.BlockNumber, TxHash: ev.Raw.TxHash}
+			// PayloadState PROCESSED; BroadcastRegistry never sets UPDATED.
+			if state == 2 {
+				d.Status = Processed
+			}
+			return d, nil
+		}
+	}
+	return Delivery{ChainID: chainID, Status: Missing}, nil
+}
+
+// receivedFrom returns the PayloadReceived events of blocks start to end whose indexed srcChainId is srcChainID.
+func receivedFrom(ctx context.Context, registry *contracts.BroadcastRegistry, start, end, srcChainID uint64) ([]*contracts.BroadcastRegistryPayloadReceived, error) {
+	it, err := registry.FilterPayloadReceived(&bind.FilterOpts{Start: start, End: &end, Context: ctx}, nil, []uint64{srcChainID})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*contracts.BroadcastRegistryPayloadReceived
+	for it.Next() {
+		out = append(out, it.Event)
+	}
+	return out, it.Error()
+}
@@ -0,0 +1,176 @@
+package broadcast
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var testRegistry = common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+// fakeChain is a destination chain whose BroadcastRegistry stored payloads. Calls it does not expect panic
+// through the nil Backend.
+type fakeChain struct {
+	Backend
+	head     uint64
+	logs     []types.Log
+	payloads map[int64][]byte
+	tracking map[int64]uint8
+	// queries and payloadCalls record what the tracker asked for.
+	queries      [][2]uint64
+	payloadCalls []int64
+}
+
+func (c *fakeChain) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(c.head)}, nil
+}
+
+func (c *fakeChain) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	c.queries = append(c.queries, [2]uint64{from, to})
+	var out []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber < from || l.BlockNumber > to || !matches(l.Topics, q.Topics) {
+			continue
+		}
+		out = append(out, l)
+	}
+	return out, nil
+}
+
+func matches(topics []common.Hash, rules [][]common.Hash) bool {
+	for i, rule := range rules {
+		if len(rule) == 0 {
+			continue
+		}
+		if i >= len(topics) {
+			return false
+		}
+		var ok bool
+		for _, h := range rule {
+			ok = ok || h == topics[i]
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *fakeChain) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	parsed, err := contracts.BroadcastRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	id := args[0].(*big.Int).Int64()
+	switch method.Name {
+	case "payload":
+		c.payloadCalls = append(c.payloadCalls, id)
+		return method.Outputs.Pack(c.payloads[id])
+	case "payloadTracking":
+		return method.Outputs.Pack(c.tracking[id])
+	default:
+		return nil, fmt.Errorf("unexpected call %s", method.Name)
+	}
+}
+
+func payloadReceivedLog(block uint64, payloadID int64, srcChainID uint64) types.Log {
+	parsed, _ := contracts.BroadcastRegistryMetaData.GetAbi()
+	return types.Log{
+		Address: testRegistry,
+		Topics: []common.Hash{
+			parsed.Events["PayloadReceived"].ID,
+			common.BigToHash(big.NewInt(payloadID)),
+			common.BigToHash(new(big.Int).SetUint64(srcChainID)),
+		},
+		BlockNumber: block,
+		TxHash:      common.BigToHash(big.NewInt(payloadID)),
+	}
+}
+
+func TestDelivery(t *testing.T) {
+	b := Broadcast{SrcChainID: 10, Payload: []byte{0x0b}}
+	tests := []struct {
+		name     string
+		tracking uint8
+		logs     []types.Log
+		want     Delivery
+		// wantCalls are the payload ids whose bytes were read.
+		wantCalls []int64
+	}{
+		{
+			name:     "processed",
+			tracking: 2,
+			logs: []types.Log{
+				payloadReceivedLog(150, 1, 10),
+				payloadReceivedLog(160, 2, 56),
+				payloadReceivedLog(260, 3, 10),
+			},
+			want:      Delivery{ChainID: 8453, Status: Processed, PayloadID: big.NewInt(3), Block: 260, TxHash: common.BigToHash(big.NewInt(3))},
+			wantCalls: []int64{1, 3},
+		},
+		{
+			name:     "stored",
+			tracking: 1,
+			logs: []types.Log{
+				payloadReceivedLog(101, 3, 10),
+			},
+			want:      Delivery{ChainID: 8453, Status: Stored, PayloadID: big.NewInt(3), Block: 101, TxHash: common.BigToHash(big.NewInt(3))},
+			wantCalls: []int64{3},
+		},
+		{
+			name: "missing",
+			logs: []types.Log{
+				payloadReceivedLog(150, 1, 10),
+				payloadReceivedLog(160, 3, 56),
+			},
+			want:      Delivery{ChainID: 8453, Status: Missing},
+			wantCalls: []int64{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &fakeChain{
+				head:     349,
+				logs:     tt.logs,
+				payloads: map[int64][]byte{1: {0x0a}, 2: {0x0b}, 3: {0x0b}},
+				tracking: map[int64]uint8{3: tt.tracking},
+			}
+			tracker := NewTracker(map[uint64]Chain{8453: {Backend: chain, BroadcastRegistry: testRegistry, FromBlock: 100, BlockRange: 100}})
+			got, err := tracker.Delivery(context.Background(), 8453, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tt.want.Status || got.Block != tt.want.Block || got.TxHash != tt.want.TxHash ||
+				(got.PayloadID == nil) != (tt.want.PayloadID == nil) || (got.PayloadID != nil && got.PayloadID.Cmp(tt.want.PayloadID) != 0) {
+				t.Fatalf("delivery %+v, want %+v", got, tt.want)
+			}
+			if fmt.Sprint(chain.payloadCalls) != fmt.Sprint(tt.wantCalls) {
+				t.Fatalf("read payloads %v, want %v", chain.payloadCalls, tt.wantCalls)
+			}
+			for _, q := range chain.queries {
+				if q[1]-q[0] >= 100 {
+					t.Fatalf("queried blocks %d-%d", q[0], q[1])
+				}
+			}
+			if tt.want.Status == Missing && fmt.Sprint(chain.queries) != "[[100 199] [200 299] [300 349]]" {
+				t.Fatalf("queried %v", chain.queries)
+			}
+		})
+	}
+}
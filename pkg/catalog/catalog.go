@@ -0,0 +1,163 @@
+// Package catalog resolves SuperPosition ids to the superform, vault and chain they represent across every
+// chain Superform is deployed on.
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+var (
+	// ErrUnknownChain is returned for superform ids encoding a chain the catalog has no backend for.
+	ErrUnknownChain = errors.New("catalog: unknown chain")
+	// ErrNotSuperform is returned for ids the chain's SuperformFactory does not know.
+	ErrNotSuperform = errors.New("catalog: superform does not exist")
+)
+
+// Chain is the access the catalog needs to one chain.
+type Chain struct {
+	Backend bind.ContractBackend
+	Factory common.Address
+}
+
+// Superform describes the superform behind a SuperPosition id.
+type Superform struct {
+	ID                   *big.Int
+	ChainID              uint64
+	FormImplementationID uint32
+	Superform            common.Address
+
+	Vault         common.Address
+	VaultName     string
+	VaultSymbol   string
+	VaultDecimals uint8
+	Asset         common.Address
+
+	// Name and Symbol are the superform yield token name and symbol, also used for the aERC20.
+	Name   string
+	Symbol string
+}
+
+// Catalog resolves and caches superform metadata. Superform metadata is immutable once created, so entries never
+// expire.
+type Catalog struct {
+	chains map[uint64]Chain
+
+	mu    sync.RWMutex
+	cache map[string]Superform
+}
+
+// New creates a Catalog over chains, keyed by chain id.
+func New(chains map[uint64]Chain) *Catalog {
+	return &Catalog{
+		chains: chains,
+		cache:  make(map[string]Superform),
+	}
+}
+
+// ChainIDs returns the chains the catalog can resolve.
+func (c *Catalog) ChainIDs() []uint64 {
+	ids := make([]uint64, 0, len(c.chains))
+	for id := range c.chains {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Chain returns the access configured for chainID.
+func (c *Catalog) Chain(chainID uint64) (Chain, bool) {
+	ch, ok := c.chains[chainID]
+	return ch, ok
+}
+
+// Superform resolves id, reading the superform on the chain encoded in the id.
+func (c *Catalog) Superform(ctx context.Context, id *big.Int) (Superform, error) {
+	key := id.String()
+
+	c.mu.RLock()
+	sf, ok := c.cache[key]
+	c.mu.RUnlock()
+	if ok {
+		return sf, nil
+	}
+
+	sf, err := c.resolve(ctx, id)
+	if err != nil {
+		return Superform{}, err
+	}
+
+	c.mu.Lock()
+	c.cache[key] = sf
+	c.mu.Unlock()
+	return sf, nil
+}
+
+func (c *Catalog) resolve(ctx context.Context, id *big.Int) (Superform, error) {
+	decoded, err := datalib.GetSuperform(id)
+	if err != nil {
+		return Superform{}, err
+	}
+	chain, ok := c.chains[decoded.ChainID]
+	if !ok {
+		return Superform{}, fmt.Errorf("%w: %d", ErrUnknownChain, decoded.ChainID)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	factory, err := contracts.NewSFFactoryCaller(chain.Factory, chain.Backend)
+	if err != nil {
+		return Superform{}, err
+	}
+	exists, err := factory.IsSuperform(opts, id)
+	if err != nil {
+		return Superform{}, err
+	}
+	if !exists {
+		return Superform{}, fmt.Errorf("%w: %s", ErrNotSuperform, id)
+	}
+
+	// every form implements the IBaseForm getters, so the ERC4626Form binding reads any of them
+	form, err := contracts.NewERC4626FormCaller(decoded.Superform, chain.Backend)
+	if err != nil {
+		return Superform{}, err
+	}
+
+	sf := Superform{
+		ID:                   id,
+		ChainID:              decoded.ChainID,
+		FormImplementationID: decoded.FormImplementationID,
+		Superform:            decoded.Superform,
+	}
+	if sf.Vault, err = form.GetVaultAddress(opts); err != nil {
+		return Superform{}, err
+	}
+	if sf.VaultName, err = form.GetVaultName(opts); err != nil {
+		return Superform{}, err
+	}
+	if sf.VaultSymbol, err = form.GetVaultSymbol(opts); err != nil {
+		return Superform{}, err
+	}
+	decimals, err := form.GetVaultDecimals(opts)
+	if err != nil {
+		return Superform{}, err
+	}
+	sf.VaultDecimals = uint8(decimals.Uint64())
+	if sf.Asset, err = form.GetVaultAsset(opts); err != nil {
+		return Superform{}, err
+	}
+	if sf.Name, err = form.SuperformYieldTokenName(opts); err != nil {
+		return Superform{}, err
+	}
+	if sf.Symbol, err = form.SuperformYieldTokenSymbol(opts); err != nil {
+		return Superform{}, err
+	}
+	return sf, nil
+}
@@ -0,0 +1,260 @@
+// Package claims indexes VaultClaimer Claimed events across chains.
+//
+// VaultClaimer stores nothing: claimProtocolOwnership only emits Claimed(claimer, protocolId), so the events are
+// the whole record of who claimed which protocol. The registry replays them from every chain, orders them by block
+// time, resolves the latest claimer per protocol id and flags protocol ids claimed by more than one address.
+package claims
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	// ErrAlreadyClaimed is returned by Claim when the sender already holds the latest claim on the protocol id.
+	ErrAlreadyClaimed = errors.New("claims: protocol already claimed by sender")
+	// ErrClaimedByOther is returned by Claim when another address holds the latest claim on the protocol id.
+	ErrClaimedByOther = errors.New("claims: protocol claimed by another address")
+)
+
+// Claim is one Claimed event.
+type Claim struct {
+	ChainID    uint64
+	Claimer    common.Address
+	ProtocolID string
+	Block      uint64
+	Time       time.Time
+	TxHash     common.Hash
+	LogIndex   uint
+}
+
+func (c Claim) before(o Claim) bool {
+	if !c.Time.Equal(o.Time) {
+		return c.Time.Before(o.Time)
+	}
+	if c.ChainID != o.ChainID {
+		return c.ChainID < o.ChainID
+	}
+	if c.Block != o.Block {
+		return c.Block < o.Block
+	}
+	return c.LogIndex < o.LogIndex
+}
+
+// Conflict is a protocol id claimed by more than one address.
+type Conflict struct {
+	ProtocolID string
+	// Claimers are the distinct claiming addresses in order of their first claim.
+	Claimers []common.Address
+	Claims   []Claim
+}
+
+// Backend is the chain access the registry needs.
+type Backend interface {
+	bind.ContractBackend
+}
+
+// Chain is a VaultClaimer deployment to index.
+type Chain struct {
+	ID           uint64
+	Backend      Backend
+	VaultClaimer common.Address
+	// FromBlock is the VaultClaimer deployment block.
+	FromBlock uint64
+}
+
+type chainState struct {
+	Chain
+	claimer *contracts.VaultClaimer
+	next    uint64
+}
+
+// Registry holds the claims of every configured chain.
+type Registry struct {
+	chains map[uint64]*chainState
+	syncMu sync.Mutex
+
+	mu     sync.RWMutex
+	claims map[string][]Claim
+}
+
+// NewRegistry creates an empty Registry over chains; call Sync to index them.
+func NewRegistry(chains []Chain) (*Registry, error) {
+	r := &Registry{
+		chains: make(map[uint64]*chainState),
+		claims: make(map[string][]Claim),
+	}
+	for _, c := range chains {
+		claimer, err := contracts.NewVaultClaimer(c.VaultClaimer, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		r.chains[c.ID] = &chainState{Chain: c, claimer: claimer, next: c.FromBlock}
+	}
+	return r, nil
+}
+
+// Sync indexes the claims emitted on every chain since the previous Sync.
+func (r *Registry) Sync(ctx context.Context) error {
+	r.syncMu.Lock()
+	defer r.syncMu.Unlock()
+
+	for _, c := range r.chains {
+		if err := r.sync(ctx, c); err != nil {
+			return fmt.Errorf("chain %d: %w", c.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *Registry) sync(ctx context.Context, c *chainState) error {
+	head, err := c.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	to := head.Number.Uint64()
+	if to < c.next {
+		return nil
+	}
+
+	it, err := c.claimer.FilterClaimed(&bind.FilterOpts{Start: c.next, End: &to, Context: ctx}, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	times := make(map[uint64]time.Time)
+	var found []Claim
+	for it.Next() {
+		ev := it.Event
+		t, ok := times[ev.Raw.BlockNumber]
+		if !ok {
+			header, err := c.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(ev.Raw.BlockNumber))
+			if err != nil {
+				return err
+			}
+			t = time.Unix(int64(header.Time), 0)
+			times[ev.Raw.BlockNumber] = t
+		}
+		found = append(found, Claim{
+			ChainID:    c.ID,
+			Claimer:    ev.Claimer,
+			ProtocolID: ev.ProtocolId,
+			Block:      ev.Raw.BlockNumber,
+			Time:       t,
+			TxHash:     ev.Raw.TxHash,
+			LogIndex:   ev.Raw.Index,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	for _, claim := range found {
+		history := append(r.claims[claim.ProtocolID], claim)
+		sort.Slice(history, func(i, j int) bool { return history[i].before(history[j]) })
+		r.claims[claim.ProtocolID] = history
+
+		if claimers := distinct(history); len(claimers) > 1 {
+			log.Warn("Conflicting protocol claim", "protocolId", claim.ProtocolID, "claimer", claim.Claimer, "chainId", claim.ChainID, "tx", claim.TxHash, "claimers", len(claimers))
+		}
+	}
+	r.mu.Unlock()
+
+	c.next = to + 1
+	return nil
+}
+
+// History returns every claim on protocolID, oldest first.
+func (r *Registry) History(protocolID string) []Claim {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Claim(nil), r.claims[protocolID]...)
+}
+
+// Latest returns the most recent claim on protocolID across all chains.
+func (r *Registry) Latest(protocolID string) (Claim, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.claims[protocolID]
+	if len(history) == 0 {
+		return Claim{}, false
+	}
+	return history[len(history)-1], true
+}
+
+// ProtocolIDs returns every claimed protocol id in lexical order.
+func (r *Registry) ProtocolIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.claims))
+	for id := range r.claims {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Conflicts returns the protocol ids claimed by more than one address.
+func (r *Registry) Conflicts() []Conflict {
+	var out []Conflict
+	for _, id := range r.ProtocolIDs() {
+		history := r.History(id)
+		if claimers := distinct(history); len(claimers) > 1 {
+			out = append(out, Conflict{ProtocolID: id, Claimers: claimers, Claims: history})
+		}
+	}
+	return out
+}
+
+func distinct(history []Claim) []common.Address {
+	seen := make(map[common.Address]bool)
+	var out []common.Address
+	for _, c := range history {
+		if !seen[c.Claimer] {
+			seen[c.Claimer] = true
+			out = append(out, c.Claimer)
+		}
+	}
+	return out
+}
+
+// Claim sends claimProtocolOwnership(protocolID) on chainID from opts.From after syncing the registry. It refuses
+// with ErrAlreadyClaimed when the sender holds the latest claim and with ErrClaimedByOther when someone else
+// does, unless force is set.
+func (r *Registry) Claim(opts *bind.TransactOpts, chainID uint64, protocolID string, force bool) (*types.Transaction, error) {
+	c, ok := r.chains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("claims: unknown chain %d", chainID)
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := r.Sync(ctx); err != nil {
+		return nil, err
+	}
+
+	if latest, ok := r.Latest(protocolID); ok && !force {
+		if latest.Claimer == opts.From {
+			return nil, fmt.Errorf("%w: %q on chain %d in %s", ErrAlreadyClaimed, protocolID, latest.ChainID, latest.TxHash)
+		}
+		return nil, fmt.Errorf("%w: %q held by %s on chain %d", ErrClaimedByOther, protocolID, latest.Claimer, latest.ChainID)
+	}
+	return c.claimer.ClaimProtocolOwnership(opts, protocolID)
+}
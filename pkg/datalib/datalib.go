@@ -0,0 +1,90 @@
+// Package datalib mirrors src/libraries/DataLib.sol for off-chain packing and unpacking of superform ids and
+// payload tx info.
+package datalib
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidChainID is returned when a superform id does not encode a chain id, matching Error.INVALID_CHAIN_ID.
+var ErrInvalidChainID = errors.New("datalib: invalid chain id")
+
+var (
+	mask8   = big.NewInt(0xff)
+	mask32  = new(big.Int).SetUint64(0xffffffff)
+	mask64  = new(big.Int).SetUint64(0xffffffffffffffff)
+	mask160 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+)
+
+// Superform is the vault-form-chain triple encoded in a superform id.
+type Superform struct {
+	Superform            common.Address
+	FormImplementationID uint32
+	ChainID              uint64
+}
+
+// PackSuperform generates the superform id of a superform, formImplementationId and chainId.
+func PackSuperform(superform common.Address, formImplementationID uint32, chainID uint64) *big.Int {
+	id := new(big.Int).SetBytes(superform.Bytes())
+	id.Or(id, new(big.Int).Lsh(new(big.Int).SetUint64(uint64(formImplementationID)), 160))
+	id.Or(id, new(big.Int).Lsh(new(big.Int).SetUint64(chainID), 192))
+	return id
+}
+
+// GetSuperform returns the superform address, form implementation id and chain id of a superform id.
+func GetSuperform(superformID *big.Int) (Superform, error) {
+	var sf Superform
+	sf.Superform = common.BigToAddress(new(big.Int).And(superformID, mask160))
+	sf.FormImplementationID = uint32(new(big.Int).And(new(big.Int).Rsh(superformID, 160), mask32).Uint64())
+	sf.ChainID = new(big.Int).And(new(big.Int).Rsh(superformID, 192), mask64).Uint64()
+
+	if sf.ChainID == 0 {
+		return Superform{}, ErrInvalidChainID
+	}
+	return sf, nil
+}
+
+// GetDestinationChain returns the chain id encoded in a superform id.
+func GetDestinationChain(superformID *big.Int) (uint64, error) {
+	sf, err := GetSuperform(superformID)
+	if err != nil {
+		return 0, err
+	}
+	return sf.ChainID, nil
+}
+
+// TxInfo is the unpacked form of the txInfo word carried by every state registry payload.
+type TxInfo struct {
+	TxType       uint8
+	CallbackType uint8
+	Multi        uint8
+	RegistryID   uint8
+	SrcSender    common.Address
+	SrcChainID   uint64
+}
+
+// PackTxInfo packs the tx info fields the same way DataLib.packTxInfo does.
+func PackTxInfo(info TxInfo) *big.Int {
+	txInfo := new(big.Int).SetUint64(uint64(info.TxType))
+	txInfo.Or(txInfo, new(big.Int).Lsh(new(big.Int).SetUint64(uint64(info.CallbackType)), 8))
+	txInfo.Or(txInfo, new(big.Int).Lsh(new(big.Int).SetUint64(uint64(info.Multi)), 16))
+	txInfo.Or(txInfo, new(big.Int).Lsh(new(big.Int).SetUint64(uint64(info.RegistryID)), 24))
+	txInfo.Or(txInfo, new(big.Int).Lsh(new(big.Int).SetBytes(info.SrcSender.Bytes()), 32))
+	txInfo.Or(txInfo, new(big.Int).Lsh(new(big.Int).SetUint64(info.SrcChainID), 192))
+	return txInfo
+}
+
+// DecodeTxInfo unpacks a txInfo word the same way DataLib.decodeTxInfo does.
+func DecodeTxInfo(txInfo *big.Int) TxInfo {
+	return TxInfo{
+		TxType:       uint8(new(big.Int).And(txInfo, mask8).Uint64()),
+		CallbackType: uint8(new(big.Int).And(new(big.Int).Rsh(txInfo, 8), mask8).Uint64()),
+		Multi:        uint8(new(big.Int).And(new(big.Int).Rsh(txInfo, 16), mask8).Uint64()),
+		RegistryID:   uint8(new(big.Int).And(new(big.Int).Rsh(txInfo, 24), mask8).Uint64()),
+		SrcSender:    common.BigToAddress(new(big.Int).And(new(big.Int).Rsh(txInfo, 32), mask160)),
+		SrcChainID:   new(big.Int).And(new(big.Int).Rsh(txInfo, 192), mask64).Uint64(),
+	}
+}
@@ -0,0 +1,115 @@
+// Package deployments reads the per-chain address books written by the deployment scripts to
+// script/output/<chainId>/<Chain>-latest.json.
+package deployments
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultDir is the address book directory relative to the repository root.
+const DefaultDir = "script/output"
+
+// Chain is the address book of one chain.
+type Chain struct {
+	ID uint64
+	// Name is the chain name taken from the address book file name, e.g. "Ethereum".
+	Name string
+	// Contracts maps contract names to their deployed address. Contracts not deployed on the chain are recorded
+	// with the zero address.
+	Contracts map[string]common.Address
+}
+
+// Address returns the address of contract, reporting false when it is missing or deployed at the zero address.
+func (c Chain) Address(contract string) (common.Address, bool) {
+	addr, ok := c.Contracts[contract]
+	return addr, ok && addr != (common.Address{})
+}
+
+// MustAddress is like Address but returns an error naming the chain when the contract is not deployed.
+func (c Chain) MustAddress(contract string) (common.Address, error) {
+	addr, ok := c.Address(contract)
+	if !ok {
+		return common.Address{}, fmt.Errorf("deployments: %s not deployed on %s (%d)", contract, c.Name, c.ID)
+	}
+	return addr, nil
+}
+
+// Deployments is the set of address books keyed by chain id.
+type Deployments map[uint64]Chain
+
+// ChainIDs returns the chain ids in ascending order.
+func (d Deployments) ChainIDs() []uint64 {
+	ids := make([]uint64, 0, len(d))
+	for id := range d {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Load reads every <dir>/<chainId>/*-latest.json address book. Empty address books are skipped.
+func Load(dir string) (Deployments, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make(Deployments)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		chainID, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(dir, entry.Name(), "*-latest.json"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			chain, err := LoadChain(file, chainID)
+			if err != nil {
+				return nil, err
+			}
+			if len(chain.Contracts) == 0 {
+				continue
+			}
+			deps[chainID] = chain
+		}
+	}
+	return deps, nil
+}
+
+// LoadChain reads a single address book for chainID.
+func LoadChain(file string, chainID uint64) (Chain, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return Chain{}, err
+	}
+	var book map[string]string
+	if err := json.Unmarshal(raw, &book); err != nil {
+		return Chain{}, fmt.Errorf("deployments: %s: %w", file, err)
+	}
+
+	chain := Chain{
+		ID:        chainID,
+		Name:      strings.TrimSuffix(filepath.Base(file), "-latest.json"),
+		Contracts: make(map[string]common.Address, len(book)),
+	}
+	for name, addr := range book {
+		if !common.IsHexAddress(addr) {
+			return Chain{}, fmt.Errorf("deployments: %s: %s has invalid address %q", file, name, addr)
+		}
+		chain.Contracts[name] = common.HexToAddress(addr)
+	}
+	return chain, nil
+}
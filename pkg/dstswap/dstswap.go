@@ -0,0 +1,264 @@
+// Package dstswap settles destination swaps of cross-chain deposits.
+//
+// A deposit vault with hasDstSwap set is bridged in an interim token that lands in DstSwapper. Before
+// CoreStateRegistry.updateDepositPayload can succeed, a DST_SWAPPER_ROLE holder must either swap it into the vault
+// asset with processTx or batchProcessTx, which deliver the asset to CoreStateRegistry and record swappedAmount, or
+// record the swap as failed with updateFailedTx or batchUpdateFailedTx, so the interim token is refunded through
+// the failed deposit rescue. The package finds stored deposit payloads with such swaps pending, obtains the swap
+// txData from a pluggable Provider and hands the final tokens and amounts of settled payloads to an Updater.
+package dstswap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+	"github.com/superform-xyz/superform-core/pkg/payloads"
+)
+
+// Native is the address LiquidityHandler uses for the chain's native token.
+var Native = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// payloadStored mirrors PayloadState.STORED.
+const payloadStored uint8 = 0
+
+// noFailedSwap is the selector of Error.INVALID_DST_SWAPPER_FAILED_SWAP, the revert of
+// getPostDstSwapFailureUpdatedTokenAmount for a swap not recorded as failed.
+var noFailedSwap = crypto.Keccak256([]byte("INVALID_DST_SWAPPER_FAILED_SWAP()"))[:4]
+
+func reverted(err error, selector []byte) bool {
+	var de rpc.DataError
+	if !errors.As(err, &de) {
+		return false
+	}
+	data, ok := de.ErrorData().(string)
+	if !ok {
+		return false
+	}
+	raw, err := hexutil.Decode(data)
+	return err == nil && bytes.HasPrefix(raw, selector)
+}
+
+// Vault is one vault of a deposit payload.
+type Vault struct {
+	// Index is the vault's position in the payload, the index DstSwapper records swaps under.
+	Index       int
+	SuperformID *big.Int
+	// Underlying is the vault asset the interim token is swapped into.
+	Underlying common.Address
+	// Amount is the payload amount of Underlying, the expected swap output.
+	Amount      *big.Int
+	MaxSlippage *big.Int
+	HasDstSwap  bool
+	// InterimToken is the bridged token a dst swap starts from.
+	InterimToken common.Address
+
+	// Swapped is DstSwapper.swappedAmount, zero until processed.
+	Swapped *big.Int
+	// Failed is the interim token amount recorded by updateFailedTx, zero unless the swap failed.
+	Failed *big.Int
+}
+
+// Pending reports whether v has a dst swap neither processed nor recorded as failed.
+func (v Vault) Pending() bool {
+	return v.HasDstSwap && v.Swapped.Sign() == 0 && v.Failed.Sign() == 0
+}
+
+// Final returns the finalTokens_ and finalAmounts_ entries updateDepositPayload expects for v: the swapped amount
+// of the vault asset, the failed amount of the interim token, or, without a dst swap, the payload amount of the
+// vault asset.
+func (v Vault) Final() (common.Address, *big.Int) {
+	switch {
+	case v.HasDstSwap && v.Failed.Sign() > 0:
+		return v.InterimToken, v.Failed
+	case v.HasDstSwap:
+		return v.Underlying, v.Swapped
+	default:
+		return v.Underlying, v.Amount
+	}
+}
+
+// Payload is a stored deposit payload of CoreStateRegistry.
+type Payload struct {
+	ID     *big.Int
+	Multi  bool
+	TxInfo datalib.TxInfo
+	Vaults []Vault
+}
+
+// Pending returns the vaults of p with a dst swap still pending.
+func (p Payload) Pending() []Vault {
+	var out []Vault
+	for _, v := range p.Vaults {
+		if v.Pending() {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// HasDstSwap reports whether any vault of p has a dst swap.
+func (p Payload) HasDstSwap() bool {
+	for _, v := range p.Vaults {
+		if v.HasDstSwap {
+			return true
+		}
+	}
+	return false
+}
+
+// Settled reports whether every dst swap of p was processed or recorded as failed.
+func (p Payload) Settled() bool {
+	return len(p.Pending()) == 0
+}
+
+// Final returns the finalTokens_ and finalAmounts_ arguments of updateDepositPayload for p.
+func (p Payload) Final() ([]common.Address, []*big.Int) {
+	tokens := make([]common.Address, len(p.Vaults))
+	amounts := make([]*big.Int, len(p.Vaults))
+	for i, v := range p.Vaults {
+		tokens[i], amounts[i] = v.Final()
+	}
+	return tokens, amounts
+}
+
+// Backend is the chain access the package needs.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Reader reads deposit payloads and their dst swap state.
+type Reader struct {
+	backend    Backend
+	registry   *contracts.CoreStateRegistry
+	swapper    *contracts.DstSwapper
+	registryAt common.Address
+	swapperAt  common.Address
+}
+
+// NewReader creates a Reader over the CoreStateRegistry and DstSwapper of one chain.
+func NewReader(backend Backend, coreStateRegistry, dstSwapper common.Address) (*Reader, error) {
+	registry, err := contracts.NewCoreStateRegistry(coreStateRegistry, backend)
+	if err != nil {
+		return nil, err
+	}
+	swapper, err := contracts.NewDstSwapper(dstSwapper, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{backend: backend, registry: registry, swapper: swapper, registryAt: coreStateRegistry, swapperAt: dstSwapper}, nil
+}
+
+// Count returns CoreStateRegistry.payloadsCount.
+func (r *Reader) Count(ctx context.Context) (*big.Int, error) {
+	return r.registry.PayloadsCount(&bind.CallOpts{Context: ctx})
+}
+
+// Payload reads payload id. It returns false when the payload is not a stored deposit, which is the only state
+// dst swaps can be settled in.
+func (r *Reader) Payload(ctx context.Context, id *big.Int) (Payload, bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	state, err := r.registry.PayloadTracking(opts, id)
+	if err != nil {
+		return Payload{}, false, err
+	}
+	if state != payloadStored {
+		return Payload{}, false, nil
+	}
+	header, err := r.registry.PayloadHeader(opts, id)
+	if err != nil {
+		return Payload{}, false, err
+	}
+	info := datalib.DecodeTxInfo(header)
+	if payloads.TxType(info.TxType) != payloads.Deposit {
+		return Payload{}, false, nil
+	}
+	body, err := r.registry.PayloadBody(opts, id)
+	if err != nil {
+		return Payload{}, false, err
+	}
+	decoded, err := payloads.Decode(id, header, body)
+	if err != nil {
+		return Payload{}, false, err
+	}
+	if !decoded.IsDeposit() {
+		return Payload{}, false, nil
+	}
+
+	p := Payload{ID: id, Multi: decoded.Multi, TxInfo: info}
+	for i, sfID := range decoded.SuperformIDs {
+		p.Vaults = append(p.Vaults, Vault{
+			Index:        i,
+			SuperformID:  sfID,
+			Amount:       decoded.Amounts[i],
+			MaxSlippage:  decoded.MaxSlippages[i],
+			HasDstSwap:   decoded.HasDstSwaps[i],
+			InterimToken: decoded.LiqData[i].InterimToken,
+		})
+	}
+
+	for i := range p.Vaults {
+		if err := r.fill(ctx, id, &p.Vaults[i]); err != nil {
+			return Payload{}, false, fmt.Errorf("dstswap: payload %s index %d: %w", id, p.Vaults[i].Index, err)
+		}
+	}
+	return p, true, nil
+}
+
+// fill reads the vault asset and dst swap state of v.
+func (r *Reader) fill(ctx context.Context, id *big.Int, v *Vault) error {
+	opts := &bind.CallOpts{Context: ctx}
+	sf, err := datalib.GetSuperform(v.SuperformID)
+	if err != nil {
+		return err
+	}
+	form, err := contracts.NewERC4626FormCaller(sf.Superform, r.backend)
+	if err != nil {
+		return err
+	}
+	if v.Underlying, err = form.GetVaultAsset(opts); err != nil {
+		return err
+	}
+
+	v.Swapped, v.Failed = new(big.Int), new(big.Int)
+	if !v.HasDstSwap {
+		return nil
+	}
+	index := big.NewInt(int64(v.Index))
+	if v.Swapped, err = r.swapper.SwappedAmount(opts, id, index); err != nil {
+		return err
+	}
+	failed, err := r.swapper.GetPostDstSwapFailureUpdatedTokenAmount(opts, id, index)
+	switch {
+	case reverted(err, noFailedSwap):
+	case err != nil:
+		return err
+	default:
+		v.Failed = failed.Amount
+	}
+	return nil
+}
+
+// Balance returns the DstSwapper balance of token, which may be Native.
+func (r *Reader) Balance(ctx context.Context, token common.Address) (*big.Int, error) {
+	if token == Native {
+		return r.backend.BalanceAt(ctx, r.swapperAt, nil)
+	}
+	erc20, err := contracts.NewIERC20Caller(token, r.backend)
+	if err != nil {
+		return nil, err
+	}
+	return erc20.BalanceOf(&bind.CallOpts{Context: ctx}, r.swapperAt)
+}
@@ -0,0 +1,415 @@
+package dstswap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// DefaultMaxAttempts is how many times a swap may fail before the keeper records it as failed.
+const DefaultMaxAttempts = 3
+
+var (
+	// ErrReverted is returned when a sent transaction reverts.
+	ErrReverted = errors.New("dstswap: transaction reverted")
+	// ErrUnsupportedFailure is returned for failed swaps of a multi vault payload that batchUpdateFailedTx cannot
+	// record. It stores each failure under indices_[indices_[i]] rather than indices_[i], so a batch is only
+	// recorded correctly when its indices are exactly 0, 1, ..., n-1; other failures must be recorded by hand.
+	ErrUnsupportedFailure = errors.New("dstswap: failed swaps cannot be recorded by batchUpdateFailedTx")
+	// ErrInvalidQuote is returned for a Quote giving a swap up without a positive AmountIn; updateFailedTx would
+	// refund nothing, or revert with ZERO_AMOUNT.
+	ErrInvalidQuote = errors.New("dstswap: failed swap quote without amount in")
+)
+
+// Request asks a Provider for the swap of one vault.
+type Request struct {
+	ChainID   uint64
+	PayloadID *big.Int
+	Vault     Vault
+	// Sender is DstSwapper, which holds the interim token and dispatches the txData.
+	Sender common.Address
+	// Receiver is CoreStateRegistry, which the swap output must be delivered to.
+	Receiver common.Address
+	// Available is the DstSwapper balance of the interim token, shared by every pending swap of that token.
+	Available *big.Int
+}
+
+// Quote is a Provider's answer to a Request.
+type Quote struct {
+	// BridgeID is the liquidity bridge id txData is validated and dispatched with.
+	BridgeID uint8
+	// TxData is the swap calldata. A Quote without TxData gives the swap up: AmountIn is recorded as failed and
+	// refunded to the user.
+	TxData []byte
+	// AmountIn is the interim token amount bridged for the vault.
+	AmountIn *big.Int
+}
+
+// Provider builds dst swap txData, e.g. from the route the deposit was quoted with.
+type Provider interface {
+	Quote(ctx context.Context, req Request) (Quote, error)
+}
+
+// Updater receives payloads whose dst swaps are all settled; Payload.Final gives the updateDepositPayload
+// arguments.
+type Updater interface {
+	UpdateDeposit(ctx context.Context, p Payload) error
+}
+
+// Action is what a keeper transaction did.
+type Action uint8
+
+const (
+	// Swap processed dst swaps.
+	Swap Action = iota
+	// Fail recorded dst swaps as failed.
+	Fail
+	// Update handed a settled payload to the Updater.
+	Update
+)
+
+func (a Action) String() string {
+	switch a {
+	case Swap:
+		return "swap"
+	case Fail:
+		return "fail"
+	case Update:
+		return "update"
+	default:
+		return fmt.Sprintf("Action(%d)", uint8(a))
+	}
+}
+
+// Result is the outcome of one keeper action on a payload.
+type Result struct {
+	PayloadID *big.Int
+	Action    Action
+	// Indices are the vault indices acted on.
+	Indices []int
+	// TxHash is unset for Update.
+	TxHash common.Hash
+	Err    error
+}
+
+// Keeper settles the dst swaps of one chain's deposit payloads from a DST_SWAPPER_ROLE holder.
+type Keeper struct {
+	reader   *Reader
+	provider Provider
+	updater  Updater
+
+	next *big.Int
+	// attempts and quotes are the failed swap count and latest quote of each pending swap.
+	attempts map[string]int
+	quotes   map[string]Quote
+
+	// MaxAttempts is how many times a swap may fail before it is recorded as failed with its last quoted AmountIn.
+	MaxAttempts int
+}
+
+// NewKeeper creates a Keeper reading payloads through reader, building swaps with provider and handing settled
+// payloads to updater.
+func NewKeeper(reader *Reader, provider Provider, updater Updater) *Keeper {
+	return &Keeper{
+		reader:      reader,
+		provider:    provider,
+		updater:     updater,
+		next:        big.NewInt(1),
+		attempts:    make(map[string]int),
+		quotes:      make(map[string]Quote),
+		MaxAttempts: DefaultMaxAttempts,
+	}
+}
+
+func attemptKey(payloadID *big.Int, index int) string {
+	return fmt.Sprintf("%s/%d", payloadID, index)
+}
+
+// Pending returns the stored deposit payloads with a dst swap, settled or not, from the oldest one the previous
+// round left behind.
+func (k *Keeper) Pending(ctx context.Context) ([]Payload, error) {
+	count, err := k.reader.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []Payload
+	for id := new(big.Int).Set(k.next); id.Cmp(count) <= 0; id.Add(id, big.NewInt(1)) {
+		p, ok, err := k.reader.Payload(ctx, new(big.Int).Set(id))
+		if err != nil {
+			return out, err
+		}
+		if ok && p.HasDstSwap() {
+			out = append(out, p)
+		}
+	}
+	if len(out) > 0 {
+		k.next = new(big.Int).Set(out[0].ID)
+	} else {
+		k.next = new(big.Int).Add(count, big.NewInt(1))
+	}
+	return out, nil
+}
+
+// Run settles every pending dst swap from opts.From and hands the payloads it settles to the Updater. Per payload
+// errors are reported in the results; the returned error is for failures reading the chain.
+func (k *Keeper) Run(opts *bind.TransactOpts) ([]Result, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	payloads, err := k.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []Result
+	for _, p := range payloads {
+		if !p.Settled() {
+			results, err := k.Settle(opts, p)
+			out = append(out, results...)
+			if err != nil {
+				return out, err
+			}
+			var ok bool
+			if p, ok, err = k.reader.Payload(ctx, p.ID); err != nil {
+				return out, err
+			}
+			if !ok || !p.Settled() {
+				continue
+			}
+		}
+		out = append(out, k.Finalize(ctx, p))
+	}
+	return out, nil
+}
+
+// Finalize hands a settled payload to the Updater.
+func (k *Keeper) Finalize(ctx context.Context, p Payload) Result {
+	res := Result{PayloadID: p.ID, Action: Update}
+	for _, v := range p.Vaults {
+		res.Indices = append(res.Indices, v.Index)
+	}
+	if res.Err = k.updater.UpdateDeposit(ctx, p); res.Err != nil {
+		log.Warn("Deposit update failed", "payloadId", p.ID, "err", res.Err)
+		return res
+	}
+	tokens, amounts := p.Final()
+	log.Info("Handed settled deposit to updater", "payloadId", p.ID, "tokens", tokens, "amounts", amounts)
+	return res
+}
+
+// Settle quotes and processes the pending dst swaps of p, and records as failed the ones the Provider gives up
+// or that failed MaxAttempts times. A give-up Quote without a positive AmountIn is reported as an ErrInvalidQuote
+// Result and left pending.
+func (k *Keeper) Settle(opts *bind.TransactOpts, p Payload) ([]Result, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	chainID, err := k.reader.swapper.CHAINID(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		swaps, fails []Vault
+		rejected     []Result
+	)
+	for _, v := range p.Pending() {
+		available, err := k.reader.Balance(ctx, v.InterimToken)
+		if err != nil {
+			return nil, err
+		}
+		q, err := k.provider.Quote(ctx, Request{
+			ChainID:   chainID,
+			PayloadID: p.ID,
+			Vault:     v,
+			Sender:    k.reader.swapperAt,
+			Receiver:  k.reader.registryAt,
+			Available: available,
+		})
+		if err != nil {
+			log.Warn("Dst swap quote failed", "payloadId", p.ID, "index", v.Index, "interimToken", v.InterimToken, "err", err)
+			continue
+		}
+		if len(q.TxData) == 0 && (q.AmountIn == nil || q.AmountIn.Sign() <= 0) {
+			err := fmt.Errorf("%w: payload %s index %d", ErrInvalidQuote, p.ID, v.Index)
+			log.Warn("Rejected dst swap quote", "payloadId", p.ID, "index", v.Index, "err", err)
+			rejected = append(rejected, Result{PayloadID: p.ID, Action: Fail, Indices: []int{v.Index}, Err: err})
+			continue
+		}
+		k.quotes[attemptKey(p.ID, v.Index)] = q
+		if len(q.TxData) == 0 {
+			fails = append(fails, v)
+		} else {
+			swaps = append(swaps, v)
+		}
+	}
+
+	var out []Result
+	if len(swaps) > 0 {
+		res := k.swap(opts, p, swaps)
+		out = append(out, res)
+		if res.Err != nil && p.Multi && len(swaps) > 1 {
+			// one bad swap reverts the whole batch; retry the swaps one by one so the others go through.
+			out = out[:len(out)-1]
+			for _, v := range swaps {
+				out = append(out, k.swap(opts, p, []Vault{v}))
+			}
+		}
+		for _, r := range out {
+			if r.Err == nil {
+				continue
+			}
+			for _, i := range r.Indices {
+				key := attemptKey(p.ID, i)
+				k.attempts[key]++
+				if q := k.quotes[key]; k.attempts[key] >= k.MaxAttempts && q.AmountIn != nil && q.AmountIn.Sign() > 0 {
+					fails = append(fails, p.Vaults[i])
+				}
+			}
+		}
+	}
+	if len(fails) > 0 {
+		sort.Slice(fails, func(i, j int) bool { return fails[i].Index < fails[j].Index })
+		out = append(out, k.fail(opts, p, fails))
+	}
+	return append(out, rejected...), nil
+}
+
+func (k *Keeper) swap(opts *bind.TransactOpts, p Payload, vaults []Vault) Result {
+	res := Result{PayloadID: p.ID, Action: Swap}
+	var (
+		indices   []*big.Int
+		bridgeIDs []uint8
+		txData    [][]byte
+	)
+	for _, v := range vaults {
+		q := k.quotes[attemptKey(p.ID, v.Index)]
+		res.Indices = append(res.Indices, v.Index)
+		indices = append(indices, big.NewInt(int64(v.Index)))
+		bridgeIDs = append(bridgeIDs, q.BridgeID)
+		txData = append(txData, q.TxData)
+	}
+	res.TxHash, res.Err = k.send(opts, func() (*types.Transaction, error) {
+		if !p.Multi {
+			return k.reader.swapper.ProcessTx(opts, p.ID, bridgeIDs[0], txData[0])
+		}
+		return k.reader.swapper.BatchProcessTx(opts, p.ID, indices, bridgeIDs, txData)
+	})
+	if res.Err != nil {
+		log.Warn("Dst swap failed", "payloadId", p.ID, "indices", res.Indices, "tx", res.TxHash, "err", res.Err)
+		return res
+	}
+	for _, i := range res.Indices {
+		delete(k.attempts, attemptKey(p.ID, i))
+		delete(k.quotes, attemptKey(p.ID, i))
+	}
+	log.Info("Processed dst swaps", "payloadId", p.ID, "indices", res.Indices, "tx", res.TxHash)
+	return res
+}
+
+func (k *Keeper) fail(opts *bind.TransactOpts, p Payload, vaults []Vault) Result {
+	res := Result{PayloadID: p.ID, Action: Fail}
+	var (
+		indices []*big.Int
+		tokens  []common.Address
+		amounts []*big.Int
+	)
+	for i, v := range vaults {
+		q := k.quotes[attemptKey(p.ID, v.Index)]
+		res.Indices = append(res.Indices, v.Index)
+		if p.Multi && v.Index != i {
+			res.Err = fmt.Errorf("%w: payload %s indices %v", ErrUnsupportedFailure, p.ID, res.Indices)
+		}
+		if q.AmountIn == nil || q.AmountIn.Sign() <= 0 {
+			res.Err = fmt.Errorf("%w: payload %s index %d", ErrInvalidQuote, p.ID, v.Index)
+			return res
+		}
+		indices = append(indices, big.NewInt(int64(v.Index)))
+		tokens = append(tokens, v.InterimToken)
+		amounts = append(amounts, q.AmountIn)
+	}
+	if res.Err != nil {
+		log.Error("Failed dst swaps need manual recording", "payloadId", p.ID, "indices", res.Indices, "interimTokens", tokens, "amounts", amounts)
+		return res
+	}
+	res.TxHash, res.Err = k.send(opts, func() (*types.Transaction, error) {
+		if !p.Multi {
+			return k.reader.swapper.UpdateFailedTx(opts, p.ID, tokens[0], amounts[0])
+		}
+		return k.reader.swapper.BatchUpdateFailedTx(opts, p.ID, indices, tokens, amounts)
+	})
+	if res.Err != nil {
+		log.Warn("Recording failed dst swaps failed", "payloadId", p.ID, "indices", res.Indices, "tx", res.TxHash, "err", res.Err)
+		return res
+	}
+	for _, i := range res.Indices {
+		delete(k.attempts, attemptKey(p.ID, i))
+		delete(k.quotes, attemptKey(p.ID, i))
+	}
+	log.Info("Recorded failed dst swaps", "payloadId", p.ID, "indices", res.Indices, "interimTokens", tokens, "amounts", amounts, "tx", res.TxHash)
+	return res
+}
+
+func (k *Keeper) send(opts *bind.TransactOpts, fn func() (*types.Transaction, error)) (common.Hash, error) {
+	tx, err := fn()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	receipt, err := bind.WaitMined(ctx, k.reader.backend, tx)
+	if err != nil {
+		return tx.Hash(), err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return tx.Hash(), fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+	}
+	return tx.Hash(), nil
+}
+
+// RegistryUpdater is an Updater sending updateDepositPayload from a CORE_STATE_REGISTRY_UPDATER_ROLE holder.
+type RegistryUpdater struct {
+	backend  Backend
+	registry *contracts.CoreStateRegistry
+	opts     *bind.TransactOpts
+}
+
+// NewRegistryUpdater creates a RegistryUpdater sending from opts.
+func NewRegistryUpdater(backend Backend, coreStateRegistry common.Address, opts *bind.TransactOpts) (*RegistryUpdater, error) {
+	registry, err := contracts.NewCoreStateRegistry(coreStateRegistry, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryUpdater{backend: backend, registry: registry, opts: opts}, nil
+}
+
+// UpdateDeposit sends updateDepositPayload with p's final tokens and amounts and waits for it.
+func (u *RegistryUpdater) UpdateDeposit(ctx context.Context, p Payload) error {
+	opts := *u.opts
+	opts.Context = ctx
+	tokens, amounts := p.Final()
+	tx, err := u.registry.UpdateDepositPayload(&opts, p.ID, tokens, amounts)
+	if err != nil {
+		return err
+	}
+	receipt, err := bind.WaitMined(ctx, u.backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Package dust sweeps token dust left on SuperformRouter, SuperformRouterPlus and forms to PayMaster.
+//
+// Each of these contracts exposes a permissionless forwardDustToPaymaster(token_) moving its whole balance of
+// token_ to PayMaster. The sweeper reads the balances every holder keeps of the tokens Superform routes, values
+// them in native token and only sweeps when the value beats the gas the forward costs. Report sums the dust
+// events to show what was swept per chain and token.
+package dust
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Kind is the type of contract holding dust.
+type Kind uint8
+
+const (
+	Router Kind = iota
+	RouterPlus
+	Form
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Router:
+		return "SuperformRouter"
+	case RouterPlus:
+		return "SuperformRouterPlus"
+	case Form:
+		return "Form"
+	default:
+		return fmt.Sprintf("Kind(%d)", uint8(k))
+	}
+}
+
+func (k Kind) abi() (*abi.ABI, error) {
+	switch k {
+	case Router:
+		return contracts.SFRouterMetaData.GetAbi()
+	case RouterPlus:
+		return contracts.SuperformRouterPlusMetaData.GetAbi()
+	default:
+		return contracts.ERC4626FormMetaData.GetAbi()
+	}
+}
+
+// Holder is a contract that can forward dust.
+type Holder struct {
+	Kind    Kind
+	Address common.Address
+	// Vault is the form's vault, whose shares cannot be forwarded. Unset for routers.
+	Vault common.Address
+}
+
+// Valuer prices token amounts in the native token of chainID, in wei. Tokens it cannot price should return a nil
+// value, which keeps them from being swept.
+type Valuer interface {
+	Value(ctx context.Context, chainID uint64, token common.Address, amount *big.Int) (*big.Int, error)
+}
+
+// Backend is the chain access the sweeper needs.
+type Backend interface {
+	bind.ContractBackend
+}
+
+// Chain is the set of holders and tokens swept on one chain.
+type Chain struct {
+	ID      uint64
+	Backend Backend
+	Holders []Holder
+	// Tokens are the tokens checked on every holder, e.g. the vault assets returned by Discover.
+	Tokens []common.Address
+}
+
+// Dust is a balance one holder keeps of one token.
+type Dust struct {
+	ChainID uint64
+	Holder  Holder
+	Token   common.Address
+	Amount  *big.Int
+	// Value is Amount priced in native wei, nil when the token could not be priced.
+	Value *big.Int
+	// GasCost is the native wei forwardDustToPaymaster is estimated to cost.
+	GasCost *big.Int
+}
+
+// Worth reports whether sweeping pays for itself.
+func (d Dust) Worth() bool {
+	return d.Value != nil && d.GasCost != nil && d.Value.Cmp(d.GasCost) > 0
+}
+
+// Sweeper scans and sweeps dust across chains.
+type Sweeper struct {
+	chains map[uint64]Chain
+	valuer Valuer
+}
+
+// NewSweeper creates a Sweeper over chains valuing dust with valuer.
+func NewSweeper(chains []Chain, valuer Valuer) *Sweeper {
+	s := &Sweeper{chains: make(map[uint64]Chain), valuer: valuer}
+	for _, c := range chains {
+		s.chains[c.ID] = c
+	}
+	return s
+}
+
+func (s *Sweeper) chainIDs() []uint64 {
+	ids := make([]uint64, 0, len(s.chains))
+	for id := range s.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Discover returns a Form holder for every superform created on factory since fromBlock, together with the vault
+// assets of those superforms, to be added to a Chain.
+func Discover(ctx context.Context, backend Backend, factory common.Address, fromBlock uint64) ([]Holder, []common.Address, error) {
+	f, err := contracts.NewSFFactoryFilterer(factory, backend)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err := f.FilterSuperformCreated(&bind.FilterOpts{Start: fromBlock, Context: ctx}, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var holders []Holder
+	for it.Next() {
+		holders = append(holders, Holder{Kind: Form, Address: it.Event.Superform, Vault: it.Event.Vault})
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	seen := make(map[common.Address]bool)
+	var assets []common.Address
+	for _, h := range holders {
+		vault, err := contracts.NewIERC4626Caller(h.Vault, backend)
+		if err != nil {
+			return nil, nil, err
+		}
+		asset, err := vault.Asset(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			// non-4626 vaults (e.g. 5115 wrappers) expose their tokens differently; skip them
+			log.Debug("Skipping vault without asset()", "vault", h.Vault, "err", err)
+			continue
+		}
+		if !seen[asset] {
+			seen[asset] = true
+			assets = append(assets, asset)
+		}
+	}
+	return holders, assets, nil
+}
+
+// Scan returns every non-zero balance of the configured tokens on every holder of every chain, valued and costed.
+// Balances whose forwardDustToPaymaster cannot be estimated are logged and left out.
+func (s *Sweeper) Scan(ctx context.Context) ([]Dust, error) {
+	var out []Dust
+	for _, id := range s.chainIDs() {
+		c := s.chains[id]
+		dust, err := s.scan(ctx, c)
+		if err != nil {
+			return out, fmt.Errorf("chain %d: %w", c.ID, err)
+		}
+		out = append(out, dust...)
+	}
+	return out, nil
+}
+
+func (s *Sweeper) scan(ctx context.Context, c Chain) ([]Dust, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	gasPrice, err := c.Backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Dust
+	for _, h := range c.Holders {
+		for _, token := range c.Tokens {
+			if h.Kind == Form && token == h.Vault {
+				continue
+			}
+			erc20, err := contracts.NewIERC20Caller(token, c.Backend)
+			if err != nil {
+				return nil, err
+			}
+			amount, err := erc20.BalanceOf(opts, h.Address)
+			if err != nil {
+				return nil, fmt.Errorf("token %s: %w", token, err)
+			}
+			if amount.Sign() == 0 {
+				continue
+			}
+
+			d := Dust{ChainID: c.ID, Holder: h, Token: token, Amount: amount}
+			if d.Value, err = s.valuer.Value(ctx, c.ID, token, amount); err != nil {
+				return nil, fmt.Errorf("value %s: %w", token, err)
+			}
+			gas, err := estimate(ctx, c.Backend, h, token)
+			if err != nil {
+				// a token the holder cannot forward (e.g. paused or blacklisting) must not hide the rest
+				log.Warn("Skipping unforwardable dust", "chainId", c.ID, "holder", h.Address, "token", token, "amount", amount, "err", err)
+				continue
+			}
+			d.GasCost = new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func estimate(ctx context.Context, backend Backend, h Holder, token common.Address) (uint64, error) {
+	parsed, err := h.Kind.abi()
+	if err != nil {
+		return 0, err
+	}
+	data, err := parsed.Pack("forwardDustToPaymaster", token)
+	if err != nil {
+		return 0, err
+	}
+	return backend.EstimateGas(ctx, ethereum.CallMsg{To: &h.Address, Data: data})
+}
+
+// Sweep forwards every dust entry worth sweeping, using the transact options of its chain. Chains without options
+// are skipped.
+func (s *Sweeper) Sweep(opts map[uint64]*bind.TransactOpts, dust []Dust) ([]*types.Transaction, error) {
+	var txs []*types.Transaction
+	for _, d := range dust {
+		txOpts, ok := opts[d.ChainID]
+		if !ok || !d.Worth() {
+			continue
+		}
+		c, ok := s.chains[d.ChainID]
+		if !ok {
+			return txs, fmt.Errorf("dust: unknown chain %d", d.ChainID)
+		}
+		parsed, err := d.Holder.Kind.abi()
+		if err != nil {
+			return txs, err
+		}
+		contract := bind.NewBoundContract(d.Holder.Address, *parsed, c.Backend, c.Backend, c.Backend)
+		tx, err := contract.Transact(txOpts, "forwardDustToPaymaster", d.Token)
+		if err != nil {
+			return txs, fmt.Errorf("chain %d %s %s: %w", d.ChainID, d.Holder.Kind, d.Token, err)
+		}
+		log.Info("Swept dust", "chainId", d.ChainID, "holder", d.Holder.Address, "kind", d.Holder.Kind, "token", d.Token, "amount", d.Amount, "value", d.Value, "tx", tx.Hash())
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
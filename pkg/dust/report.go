@@ -0,0 +1,120 @@
+package dust
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Swept is the total of one token forwarded to PayMaster by one kind of holder on one chain.
+type Swept struct {
+	ChainID uint64
+	Kind    Kind
+	Token   common.Address
+	Amount  *big.Int
+	// Sweeps is the number of dust events summed into Amount.
+	Sweeps int
+}
+
+type sweptKey struct {
+	chainID uint64
+	kind    Kind
+	token   common.Address
+}
+
+type sweptTotals map[sweptKey]*Swept
+
+func (t sweptTotals) add(chainID uint64, kind Kind, token common.Address, amount *big.Int) {
+	k := sweptKey{chainID, kind, token}
+	s := t[k]
+	if s == nil {
+		s = &Swept{ChainID: chainID, Kind: kind, Token: token, Amount: new(big.Int)}
+		t[k] = s
+	}
+	s.Amount.Add(s.Amount, amount)
+	s.Sweeps++
+}
+
+// Report sums the RouterDustForwardedToPaymaster, RouterPlusDustForwardedToPaymaster and
+// FormDustForwardedToPaymaster events of every holder since fromBlock, ordered by chain, kind and token.
+func (s *Sweeper) Report(ctx context.Context, fromBlock uint64) ([]Swept, error) {
+	totals := make(sweptTotals)
+	opts := &bind.FilterOpts{Start: fromBlock, Context: ctx}
+
+	for _, id := range s.chainIDs() {
+		c := s.chains[id]
+		for _, h := range c.Holders {
+			if err := report(opts, c, h, totals); err != nil {
+				return nil, fmt.Errorf("chain %d %s %s: %w", c.ID, h.Kind, h.Address, err)
+			}
+		}
+	}
+
+	out := make([]Swept, 0, len(totals))
+	for _, t := range totals {
+		out = append(out, *t)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ChainID != out[j].ChainID {
+			return out[i].ChainID < out[j].ChainID
+		}
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		return out[i].Token.Cmp(out[j].Token) < 0
+	})
+	return out, nil
+}
+
+func report(opts *bind.FilterOpts, c Chain, h Holder, totals sweptTotals) error {
+	switch h.Kind {
+	case Router:
+		f, err := contracts.NewSFRouterFilterer(h.Address, c.Backend)
+		if err != nil {
+			return err
+		}
+		it, err := f.FilterRouterDustForwardedToPaymaster(opts, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			totals.add(c.ID, h.Kind, it.Event.Token, it.Event.Amount)
+		}
+		return it.Error()
+	case RouterPlus:
+		f, err := contracts.NewSuperformRouterPlusFilterer(h.Address, c.Backend)
+		if err != nil {
+			return err
+		}
+		it, err := f.FilterRouterPlusDustForwardedToPaymaster(opts, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			totals.add(c.ID, h.Kind, it.Event.Token, it.Event.Amount)
+		}
+		return it.Error()
+	default:
+		f, err := contracts.NewERC4626FormFilterer(h.Address, c.Backend)
+		if err != nil {
+			return err
+		}
+		it, err := f.FilterFormDustForwardedToPaymaster(opts, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for it.Next() {
+			totals.add(c.ID, h.Kind, it.Event.Token, it.Event.Amount)
+		}
+		return it.Error()
+	}
+}
@@ -0,0 +1,237 @@
+package emergency
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+// ErrReverted is returned when an execution transaction reverted.
+var ErrReverted = errors.New("emergency: execution reverted")
+
+// Outcome is what happened to a withdrawal handed to the executor.
+type Outcome uint8
+
+const (
+	// Processed withdrawals were executed and reconciled.
+	Processed Outcome = iota
+	// Uncovered withdrawals were held back because the form cannot pay them, nor any later withdrawal of the form.
+	Uncovered
+	// Failed withdrawals were sent in a transaction that failed or reverted.
+	Failed
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Processed:
+		return "processed"
+	case Uncovered:
+		return "uncovered"
+	case Failed:
+		return "failed"
+	default:
+		return fmt.Sprintf("Outcome(%d)", uint8(o))
+	}
+}
+
+// Result is the outcome of one withdrawal.
+type Result struct {
+	Withdrawal
+	Outcome Outcome
+	// Received is the vault share amount the form's EmergencyWithdrawalProcessed event reports sent to the receiver.
+	Received *big.Int
+	TxHash   common.Hash
+	Err      error
+}
+
+// Receiver is the reconciliation of one receiver across an execution.
+type Receiver struct {
+	Address common.Address
+	// Owed sums the queued amounts of the receiver's processed withdrawals.
+	Owed *big.Int
+	// Received sums what the forms reported sending.
+	Received *big.Int
+	// Pending sums the amounts of the receiver's withdrawals left unprocessed.
+	Pending *big.Int
+	Results []Result
+}
+
+// Reconciled reports whether the receiver got exactly what its processed withdrawals owed.
+func (r Receiver) Reconciled() bool {
+	return r.Owed.Cmp(r.Received) == 0
+}
+
+// Report is the outcome of an execution.
+type Report struct {
+	Results []Result
+}
+
+// Receivers groups the results by receiver, in address order.
+func (r Report) Receivers() []Receiver {
+	index := make(map[common.Address]int)
+	var out []Receiver
+	for _, res := range r.Results {
+		i, ok := index[res.Receiver]
+		if !ok {
+			i = len(out)
+			index[res.Receiver] = i
+			out = append(out, Receiver{Address: res.Receiver, Owed: new(big.Int), Received: new(big.Int), Pending: new(big.Int)})
+		}
+		rec := &out[i]
+		if res.Outcome == Processed {
+			rec.Owed.Add(rec.Owed, res.Amount)
+			rec.Received.Add(rec.Received, res.Received)
+		} else {
+			rec.Pending.Add(rec.Pending, res.Amount)
+		}
+		rec.Results = append(rec.Results, res)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Address.Cmp(out[j].Address) < 0 })
+	return out
+}
+
+// Executor processes queued withdrawals of one chain.
+type Executor struct {
+	*Monitor
+}
+
+// NewExecutor creates an Executor for the EmergencyQueue and SuperformFactory of one chain.
+func NewExecutor(backend Backend, emergencyQueue, factory common.Address) (*Executor, error) {
+	m, err := NewMonitor(backend, emergencyQueue, factory)
+	if err != nil {
+		return nil, err
+	}
+	return &Executor{Monitor: m}, nil
+}
+
+// Execute processes withdrawals in queue order from opts.From, which must hold EMERGENCY_ADMIN_ROLE, sending up to
+// batchSize ids per transaction. Once a form cannot cover a withdrawal, it and every later withdrawal of that form
+// are held back as Uncovered, so no receiver is paid ahead of an earlier one. Each transaction is waited for and its
+// logs are reconciled.
+func (e *Executor) Execute(opts *bind.TransactOpts, withdrawals []Withdrawal, batchSize int) (Report, error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	sorted := append([]Withdrawal(nil), withdrawals...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ID.Cmp(sorted[j].ID) < 0 })
+
+	coverage, err := e.Coverage(opts.Context, sorted)
+	if err != nil {
+		return Report{}, err
+	}
+	remaining := make(map[common.Address]*big.Int, len(coverage))
+	for _, c := range coverage {
+		remaining[c.Superform] = new(big.Int).Set(c.Balance)
+	}
+
+	var report Report
+	var runnable []int
+	blocked := make(map[common.Address]bool)
+	for _, w := range sorted {
+		sf, err := datalib.GetSuperform(w.SuperformID)
+		if err != nil {
+			return Report{}, err
+		}
+		left := remaining[sf.Superform]
+		if blocked[sf.Superform] || left.Cmp(w.Amount) < 0 {
+			blocked[sf.Superform] = true
+			report.Results = append(report.Results, Result{Withdrawal: w, Outcome: Uncovered})
+			continue
+		}
+		left.Sub(left, w.Amount)
+		runnable = append(runnable, len(report.Results))
+		report.Results = append(report.Results, Result{Withdrawal: w, Outcome: Failed})
+	}
+
+	for start := 0; start < len(runnable); start += batchSize {
+		end := min(start+batchSize, len(runnable))
+		batch := runnable[start:end]
+		if err := e.execute(opts, report.Results, batch); err != nil {
+			for _, i := range runnable[start:] {
+				if report.Results[i].Err == nil {
+					report.Results[i].Err = err
+				}
+			}
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+func (e *Executor) execute(opts *bind.TransactOpts, results []Result, batch []int) error {
+	ids := make([]*big.Int, len(batch))
+	byID := make(map[string]*Result, len(batch))
+	for n, i := range batch {
+		ids[n] = results[i].ID
+		byID[results[i].ID.String()] = &results[i]
+	}
+
+	tx, err := e.queue.execute(opts, ids)
+	if err != nil {
+		return err
+	}
+	for _, r := range byID {
+		r.TxHash = tx.Hash()
+	}
+	receipt, err := bind.WaitMined(opts.Context, e.backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+	}
+
+	form, err := contracts.NewERC4626FormFilterer(common.Address{}, nil)
+	if err != nil {
+		return err
+	}
+	// the form logs EmergencyWithdrawalProcessed right before the queue logs WithdrawalProcessed for the same id
+	sent := make(map[common.Address]*big.Int)
+	for _, l := range receipt.Logs {
+		if l.Address == e.queue.address {
+			ev, err := e.queue.ParseWithdrawalProcessed(*l)
+			if err != nil {
+				continue
+			}
+			r, ok := byID[ev.Id.String()]
+			if !ok {
+				continue
+			}
+			sf, err := datalib.GetSuperform(ev.SuperformId)
+			if err != nil {
+				return err
+			}
+			r.Outcome, r.Received = Processed, new(big.Int)
+			if amount, ok := sent[sf.Superform]; ok {
+				r.Received = amount
+				delete(sent, sf.Superform)
+			}
+			if r.Received.Cmp(r.Amount) != 0 {
+				log.Warn("Emergency withdrawal amount mismatch", "id", r.ID, "receiver", r.Receiver, "owed", r.Amount, "received", r.Received, "tx", r.TxHash)
+			}
+			log.Info("Processed emergency withdrawal", "id", r.ID, "receiver", r.Receiver, "superformId", r.SuperformID, "amount", r.Received, "tx", r.TxHash)
+			continue
+		}
+		ev, err := form.ParseEmergencyWithdrawalProcessed(*l)
+		if err != nil {
+			continue
+		}
+		sent[l.Address] = ev.Amount
+	}
+
+	for _, r := range byID {
+		if r.Outcome != Processed {
+			r.Err = fmt.Errorf("emergency: no WithdrawalProcessed for id %s in %s", r.ID, tx.Hash())
+		}
+	}
+	return nil
+}
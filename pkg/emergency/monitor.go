@@ -0,0 +1,233 @@
+package emergency
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+// Coverage compares what one superform owes its queued withdrawals with the vault shares it holds.
+type Coverage struct {
+	Superform            common.Address
+	SuperformID          *big.Int
+	FormImplementationID uint32
+	// Paused reports whether the form implementation is paused; queued withdrawals on an unpaused form are
+	// leftovers of an earlier pause.
+	Paused bool
+	// Balance is the form's vault share balance (getVaultShareBalance).
+	Balance *big.Int
+	// Owed is the sum of the unprocessed withdrawal amounts.
+	Owed        *big.Int
+	Withdrawals []Withdrawal
+}
+
+// Shortfall returns how many vault shares the form misses to process every queued withdrawal, zero when covered.
+func (c Coverage) Shortfall() *big.Int {
+	missing := new(big.Int).Sub(c.Owed, c.Balance)
+	if missing.Sign() < 0 {
+		return new(big.Int)
+	}
+	return missing
+}
+
+// Covered reports whether the form holds enough shares for every queued withdrawal.
+func (c Coverage) Covered() bool {
+	return c.Balance.Cmp(c.Owed) >= 0
+}
+
+// Backend is the chain access the monitor and executor need.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Monitor reads the EmergencyQueue of one chain.
+type Monitor struct {
+	backend Backend
+	queue   *queue
+	factory *contracts.SFFactoryCaller
+}
+
+// NewMonitor creates a Monitor for the EmergencyQueue and SuperformFactory of one chain.
+func NewMonitor(backend Backend, emergencyQueue, factory common.Address) (*Monitor, error) {
+	q, err := newQueue(emergencyQueue, backend)
+	if err != nil {
+		return nil, err
+	}
+	f, err := contracts.NewSFFactoryCaller(factory, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Monitor{backend: backend, queue: q, factory: f}, nil
+}
+
+// Pending returns every unprocessed withdrawal with an id of at least fromID, in queue order. Ids start at 1, so a
+// fromID of 0 or 1 reads the whole queue.
+func (m *Monitor) Pending(ctx context.Context, fromID uint64) ([]Withdrawal, error) {
+	last, err := m.queue.counter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if fromID == 0 {
+		fromID = 1
+	}
+
+	var out []Withdrawal
+	for id := new(big.Int).SetUint64(fromID); id.Cmp(last) <= 0; id.Add(id, common.Big1) {
+		w, err := m.queue.withdrawal(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("withdrawal %s: %w", id, err)
+		}
+		if !w.Processed {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+// Filter narrows withdrawals down; zero fields match everything.
+type Filter struct {
+	Receiver    common.Address
+	SuperformID *big.Int
+	// MinAmount drops withdrawals of fewer vault shares.
+	MinAmount *big.Int
+}
+
+// Match reports whether w passes the filter.
+func (f Filter) Match(w Withdrawal) bool {
+	if f.Receiver != (common.Address{}) && f.Receiver != w.Receiver {
+		return false
+	}
+	if f.SuperformID != nil && f.SuperformID.Cmp(w.SuperformID) != 0 {
+		return false
+	}
+	return f.MinAmount == nil || w.Amount.Cmp(f.MinAmount) >= 0
+}
+
+// List returns the unprocessed withdrawals from fromID matching filter, in queue order.
+func (m *Monitor) List(ctx context.Context, fromID uint64, filter Filter) ([]Withdrawal, error) {
+	pending, err := m.Pending(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	out := pending[:0]
+	for _, w := range pending {
+		if filter.Match(w) {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+// Stamp sets Block and QueuedAt on withdrawals from their WithdrawalQueued events, searching from fromBlock,
+// typically the queue's deployment block. Withdrawals whose event is not found are left unstamped.
+func (m *Monitor) Stamp(ctx context.Context, withdrawals []Withdrawal, fromBlock uint64) error {
+	if len(withdrawals) == 0 {
+		return nil
+	}
+	ids := make([]*big.Int, len(withdrawals))
+	for i, w := range withdrawals {
+		ids[i] = w.ID
+	}
+	it, err := m.queue.FilterWithdrawalQueued(&bind.FilterOpts{Start: fromBlock, Context: ctx}, nil, ids, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	blocks := make(map[string]uint64)
+	for it.Next() {
+		blocks[it.Event.Id.String()] = it.Event.Raw.BlockNumber
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	times := make(map[uint64]time.Time)
+	for i := range withdrawals {
+		n, ok := blocks[withdrawals[i].ID.String()]
+		if !ok {
+			continue
+		}
+		t, ok := times[n]
+		if !ok {
+			head, err := m.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+			if err != nil {
+				return err
+			}
+			t = time.Unix(int64(head.Time), 0)
+			times[n] = t
+		}
+		withdrawals[i].Block, withdrawals[i].QueuedAt = n, t
+	}
+	return nil
+}
+
+// Coverage groups withdrawals by superform and compares each group with the form's vault share balance. Forms are
+// returned in order of their first withdrawal.
+func (m *Monitor) Coverage(ctx context.Context, withdrawals []Withdrawal) ([]Coverage, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	index := make(map[common.Address]int)
+	var out []Coverage
+	for _, w := range withdrawals {
+		sf, err := datalib.GetSuperform(w.SuperformID)
+		if err != nil {
+			return nil, fmt.Errorf("withdrawal %s: %w", w.ID, err)
+		}
+		i, ok := index[sf.Superform]
+		if !ok {
+			form, err := contracts.NewERC4626FormCaller(sf.Superform, m.backend)
+			if err != nil {
+				return nil, err
+			}
+			balance, err := form.GetVaultShareBalance(opts)
+			if err != nil {
+				return nil, fmt.Errorf("superform %s: %w", sf.Superform, err)
+			}
+			paused, err := m.factory.IsFormImplementationPaused(opts, sf.FormImplementationID)
+			if err != nil {
+				return nil, err
+			}
+			i = len(out)
+			index[sf.Superform] = i
+			out = append(out, Coverage{
+				Superform:            sf.Superform,
+				SuperformID:          w.SuperformID,
+				FormImplementationID: sf.FormImplementationID,
+				Paused:               paused,
+				Balance:              balance,
+				Owed:                 new(big.Int),
+			})
+		}
+		out[i].Owed.Add(out[i].Owed, w.Amount)
+		out[i].Withdrawals = append(out[i].Withdrawals, w)
+	}
+	return out, nil
+}
+
+// Check reads the pending withdrawals and their coverage, warning about every form that cannot pay what it owes.
+func (m *Monitor) Check(ctx context.Context, fromID uint64) ([]Coverage, error) {
+	pending, err := m.Pending(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := m.Coverage(ctx, pending)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range report {
+		if !c.Covered() {
+			log.Warn("Emergency withdrawals exceed form vault shares", "superform", c.Superform, "superformId", c.SuperformID, "queued", len(c.Withdrawals), "owed", c.Owed, "balance", c.Balance, "shortfall", c.Shortfall())
+			continue
+		}
+		log.Info("Queued emergency withdrawals", "superform", c.Superform, "superformId", c.SuperformID, "paused", c.Paused, "queued", len(c.Withdrawals), "owed", c.Owed, "balance", c.Balance)
+	}
+	return report, nil
+}
@@ -0,0 +1,83 @@
+// Package emergency monitors and processes EmergencyQueue withdrawals.
+//
+// When a form implementation is paused, withdrawals reaching a form are not sent to the vault but queued in
+// EmergencyQueue. An emergency admin later calls executeQueuedWithdrawal, which has the form transfer vault shares
+// to the receiver through emergencyWithdraw; the form reverts unless it holds enough shares. The monitor lists the
+// unprocessed withdrawals and compares what each form owes with its vault share balance, the executor processes
+// them in queue order and reconciles what every receiver got, and the watchdog alerts on withdrawals left queued
+// too long and hands every pending one to the executor.
+package emergency
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Withdrawal is one queued withdrawal, mirroring QueuedWithdrawal.
+type Withdrawal struct {
+	ID           *big.Int
+	Receiver     common.Address
+	SuperformID  *big.Int
+	Amount       *big.Int
+	SrcPayloadID *big.Int
+	Processed    bool
+	// Block and QueuedAt locate the WithdrawalQueued event; they are zero until stamped by Monitor.Stamp.
+	Block    uint64
+	QueuedAt time.Time
+}
+
+// Age returns how long the withdrawal has been queued at now, zero when it was not stamped.
+func (w Withdrawal) Age(now time.Time) time.Duration {
+	if w.QueuedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(w.QueuedAt)
+}
+
+// queue binds the EmergencyQueue of one chain.
+type queue struct {
+	address common.Address
+	*contracts.EmergencyQueue
+}
+
+func newQueue(address common.Address, backend bind.ContractBackend) (*queue, error) {
+	q, err := contracts.NewEmergencyQueue(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &queue{address: address, EmergencyQueue: q}, nil
+}
+
+// counter returns the id of the last queued withdrawal; ids start at 1.
+func (q *queue) counter(ctx context.Context) (*big.Int, error) {
+	return q.QueueCounter(&bind.CallOpts{Context: ctx})
+}
+
+func (q *queue) withdrawal(ctx context.Context, id *big.Int) (Withdrawal, error) {
+	out, err := q.QueuedWithdrawal(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return Withdrawal{}, err
+	}
+	return Withdrawal{
+		ID:           new(big.Int).Set(id),
+		Receiver:     out.ReceiverAddress,
+		SuperformID:  out.SuperformId,
+		Amount:       out.Amount,
+		SrcPayloadID: out.SrcPayloadId,
+		Processed:    out.IsProcessed,
+	}, nil
+}
+
+func (q *queue) execute(opts *bind.TransactOpts, ids []*big.Int) (*types.Transaction, error) {
+	if len(ids) == 1 {
+		return q.ExecuteQueuedWithdrawal(opts, ids[0])
+	}
+	return q.BatchExecuteQueuedWithdrawal(opts, ids)
+}
@@ -0,0 +1,101 @@
+package emergency
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// DefaultMaxAge is how long a withdrawal may stay queued before the watchdog alerts on it.
+const DefaultMaxAge = 24 * time.Hour
+
+// Watch is the outcome of one watchdog round.
+type Watch struct {
+	// Pending is every unprocessed withdrawal, stamped with its queue time.
+	Pending []Withdrawal
+	// Overdue is the part of Pending queued for longer than the watchdog's MaxAge.
+	Overdue []Withdrawal
+	// Report is the execution of Pending; it is empty when the round only watched.
+	Report Report
+}
+
+// Watchdog keeps every queued withdrawal of one chain in sight until it is processed. Each round rereads the queue
+// from the oldest withdrawal still unprocessed, so withdrawals the executor held back or failed are picked up again.
+type Watchdog struct {
+	executor  *Executor
+	fromBlock uint64
+	next      uint64
+
+	// MaxAge is the queue time past which a withdrawal is reported overdue.
+	MaxAge time.Duration
+	// BatchSize is passed to Executor.Execute.
+	BatchSize int
+}
+
+// NewWatchdog creates a Watchdog over executor. fromBlock bounds the WithdrawalQueued search, typically the queue's
+// deployment block.
+func NewWatchdog(executor *Executor, fromBlock uint64) *Watchdog {
+	return &Watchdog{executor: executor, fromBlock: fromBlock, next: 1, MaxAge: DefaultMaxAge, BatchSize: 1}
+}
+
+// Watch reads the pending withdrawals and alerts on the overdue ones without executing anything.
+func (d *Watchdog) Watch(ctx context.Context) (Watch, error) {
+	return d.round(ctx, nil)
+}
+
+// Run watches, then executes every pending withdrawal from opts.From, which must hold EMERGENCY_ADMIN_ROLE.
+func (d *Watchdog) Run(opts *bind.TransactOpts) (Watch, error) {
+	return d.round(opts.Context, opts)
+}
+
+func (d *Watchdog) round(ctx context.Context, opts *bind.TransactOpts) (Watch, error) {
+	last, err := d.executor.queue.counter(ctx)
+	if err != nil {
+		return Watch{}, err
+	}
+	pending, err := d.executor.Pending(ctx, d.next)
+	if err != nil {
+		return Watch{}, err
+	}
+	if err := d.executor.Stamp(ctx, pending, d.fromBlock); err != nil {
+		return Watch{}, err
+	}
+	if len(pending) > 0 {
+		d.next = pending[0].ID.Uint64()
+	} else if last.IsUint64() {
+		d.next = last.Uint64() + 1
+	}
+
+	w := Watch{Pending: pending}
+	now := time.Now()
+	for _, p := range pending {
+		if p.QueuedAt.IsZero() {
+			log.Warn("Queued emergency withdrawal without WithdrawalQueued event", "id", p.ID, "receiver", p.Receiver, "superformId", p.SuperformID, "fromBlock", d.fromBlock)
+			continue
+		}
+		if age := p.Age(now); age > d.MaxAge {
+			w.Overdue = append(w.Overdue, p)
+			log.Error("Emergency withdrawal overdue", "id", p.ID, "receiver", p.Receiver, "superformId", p.SuperformID, "amount", p.Amount, "queuedAt", p.QueuedAt, "age", age.Round(time.Minute))
+		}
+	}
+	if len(pending) > 0 {
+		log.Info("Queued emergency withdrawals", "pending", len(pending), "overdue", len(w.Overdue), "owed", owed(pending))
+	}
+	if opts == nil || len(pending) == 0 {
+		return w, nil
+	}
+
+	w.Report, err = d.executor.Execute(opts, pending, d.BatchSize)
+	return w, err
+}
+
+func owed(withdrawals []Withdrawal) *big.Int {
+	total := new(big.Int)
+	for _, w := range withdrawals {
+		total.Add(total, w.Amount)
+	}
+	return total
+}
@@ -0,0 +1,176 @@
+package forms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	// ErrInvalidTokenIn is returned when a token cannot be deposited into an ERC5115 superform.
+	ErrInvalidTokenIn = errors.New("forms: invalid 5115 token in")
+	// ErrInvalidTokenOut is returned when a token cannot be withdrawn from an ERC5115 superform.
+	ErrInvalidTokenOut = errors.New("forms: invalid 5115 token out")
+)
+
+var (
+	uint256Type, _  = abi.NewType("uint256", "", nil)
+	bytesArrType, _ = abi.NewType("bytes[]", "", nil)
+	bytesType, _    = abi.NewType("bytes", "", nil)
+	addressType, _  = abi.NewType("address", "", nil)
+
+	extraFormDataArgs = abi.Arguments{{Type: uint256Type}, {Type: bytesArrType}}
+	entryArgs         = abi.Arguments{{Type: uint256Type}, {Type: bytesType}}
+	tokenInArgs       = abi.Arguments{{Type: addressType}}
+)
+
+// TokenIn is the input token of one ERC5115 superform of a deposit.
+type TokenIn struct {
+	SuperformID *big.Int
+	Token       common.Address
+}
+
+// EncodeExtraFormData encodes the ExtraFormData ERC5115Form decodes its token in from:
+// abi.encode(uint256 nVaults, bytes[] abi.encode(uint256 superformId, abi.encode(address tokenIn))). Deposits into
+// several vaults carry one entry per ERC5115 superform.
+func EncodeExtraFormData(entries []TokenIn) ([]byte, error) {
+	encoded := make([][]byte, len(entries))
+	for i, e := range entries {
+		token, err := tokenInArgs.Pack(e.Token)
+		if err != nil {
+			return nil, err
+		}
+		if encoded[i], err = entryArgs.Pack(e.SuperformID, token); err != nil {
+			return nil, err
+		}
+	}
+	return extraFormDataArgs.Pack(big.NewInt(int64(len(entries))), encoded)
+}
+
+// DecodeExtraFormData is the inverse of EncodeExtraFormData. Only the first nVaults entries are read, as on chain.
+func DecodeExtraFormData(data []byte) ([]TokenIn, error) {
+	values, err := extraFormDataArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("forms: extra form data: %w", err)
+	}
+	n := values[0].(*big.Int)
+	encoded := values[1].([][]byte)
+	if !n.IsUint64() || n.Uint64() > uint64(len(encoded)) {
+		return nil, fmt.Errorf("forms: extra form data: %s vaults but %d entries", n, len(encoded))
+	}
+
+	out := make([]TokenIn, n.Uint64())
+	for i := range out {
+		entry, err := entryArgs.Unpack(encoded[i])
+		if err != nil {
+			return nil, fmt.Errorf("forms: extra form data entry %d: %w", i, err)
+		}
+		token, err := tokenInArgs.Unpack(entry[1].([]byte))
+		if err != nil {
+			return nil, fmt.Errorf("forms: extra form data entry %d: %w", i, err)
+		}
+		out[i] = TokenIn{SuperformID: entry[0].(*big.Int), Token: token[0].(common.Address)}
+	}
+	return out, nil
+}
+
+// ERC5115Form is a superform over an ERC5115To4626Wrapper.
+type ERC5115Form struct {
+	base
+	Caller  *contracts.ERC5115FormCaller
+	backend bind.ContractCaller
+}
+
+// NewERC5115Form binds the ERC5115 superform at address.
+func NewERC5115Form(address common.Address, backend bind.ContractCaller) (*ERC5115Form, error) {
+	caller, err := contracts.NewERC5115FormCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC5115Form{base: base{kind: ERC5115, address: address, caller: caller}, Caller: caller, backend: backend}, nil
+}
+
+// Wrapper binds the form's vault, the ERC5115To4626Wrapper around the underlying 5115 vault.
+func (f *ERC5115Form) Wrapper(ctx context.Context) (*contracts.IERC5115To4626WrapperCaller, error) {
+	vault, err := f.Vault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return contracts.NewIERC5115To4626WrapperCaller(vault, f.backend)
+}
+
+// TokensIn returns every token the underlying 5115 vault accepts.
+func (f *ERC5115Form) TokensIn(ctx context.Context) ([]common.Address, error) {
+	return f.Caller.GetTokensIn(&bind.CallOpts{Context: ctx})
+}
+
+// TokensOut returns every token the underlying 5115 vault redeems to.
+func (f *ERC5115Form) TokensOut(ctx context.Context) ([]common.Address, error) {
+	return f.Caller.GetTokensOut(&bind.CallOpts{Context: ctx})
+}
+
+// SelectTokenIn returns the token deposits must arrive in. The wrapper always deposits its main token in, so a
+// zero want selects it and any other token is refused, with a hint when the 5115 vault itself would take it.
+func (f *ERC5115Form) SelectTokenIn(ctx context.Context, want common.Address) (common.Address, error) {
+	wrapper, err := f.Wrapper(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	main, err := wrapper.GetMainTokenIn(opts)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if want == (common.Address{}) || want == main {
+		return main, nil
+	}
+	valid, err := f.Caller.IsValidTokenIn(opts, want)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if valid {
+		return common.Address{}, fmt.Errorf("%w: %s is accepted by the 5115 vault but the wrapper deposits %s", ErrInvalidTokenIn, want, main)
+	}
+	return common.Address{}, fmt.Errorf("%w: %s", ErrInvalidTokenIn, want)
+}
+
+// SelectTokenOut returns the token withdrawals pay out in, to be set as LiqRequest.InterimToken. The same rules as
+// SelectTokenIn apply to the wrapper's main token out.
+func (f *ERC5115Form) SelectTokenOut(ctx context.Context, want common.Address) (common.Address, error) {
+	wrapper, err := f.Wrapper(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	main, err := wrapper.GetMainTokenOut(opts)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if want == (common.Address{}) || want == main {
+		return main, nil
+	}
+	valid, err := f.Caller.IsValidTokenOut(opts, want)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if valid {
+		return common.Address{}, fmt.Errorf("%w: %s is redeemable from the 5115 vault but the wrapper redeems to %s", ErrInvalidTokenOut, want, main)
+	}
+	return common.Address{}, fmt.Errorf("%w: %s", ErrInvalidTokenOut, want)
+}
+
+// ExtraFormData selects the token in for a deposit into superformID and encodes it as the deposit's ExtraFormData.
+func (f *ERC5115Form) ExtraFormData(ctx context.Context, superformID *big.Int, want common.Address) ([]byte, error) {
+	token, err := f.SelectTokenIn(ctx, want)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeExtraFormData([]TokenIn{{SuperformID: superformID, Token: token}})
+}
@@ -0,0 +1,213 @@
+// Package forms reads superforms through one interface whatever the form implementation behind them.
+//
+// Every form derives from BaseForm, so the price per share, previews, asset and vault metadata views are shared;
+// what differs is how deposits pick their input token. ERC4626 superforms deposit their asset, while ERC5115
+// superforms deposit through an ERC5115To4626Wrapper that pins one token in and one token out, the token in being
+// passed in ExtraFormData and the token out as the withdrawal interim token.
+package forms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+var (
+	// ErrUnknownKind is returned by Open when the superform's form implementation id has no known Kind.
+	ErrUnknownKind = errors.New("forms: unknown form implementation")
+	// ErrUnsupported is returned for a view the form implementation reverts with NOT_IMPLEMENTED.
+	ErrUnsupported = errors.New("forms: not supported by form")
+)
+
+// Kind is the form implementation behind a superform.
+type Kind uint8
+
+const (
+	ERC4626 Kind = iota + 1
+	ERC5115
+	ERC7540
+)
+
+func (k Kind) String() string {
+	switch k {
+	case ERC4626:
+		return "ERC4626Form"
+	case ERC5115:
+		return "ERC5115Form"
+	case ERC7540:
+		return "ERC7540Form"
+	default:
+		return fmt.Sprintf("Kind(%d)", uint8(k))
+	}
+}
+
+// ProductionKinds maps the form implementation ids registered by the production deployment to their Kind.
+var ProductionKinds = map[uint32]Kind{1: ERC4626, 3: ERC5115, 4: ERC7540}
+
+// StagingKinds maps the form implementation ids registered by the staging deployment to their Kind.
+var StagingKinds = map[uint32]Kind{1: ERC4626, 5: ERC5115, 7: ERC7540}
+
+// VaultMetadata is the vault description every form exposes.
+type VaultMetadata struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+	// TotalAssets and TotalSupply are the vault's, as reported by the form.
+	TotalAssets *big.Int
+	TotalSupply *big.Int
+}
+
+// Form is the read access shared by every form implementation.
+type Form interface {
+	Kind() Kind
+	// Address is the superform address.
+	Address() common.Address
+	Vault(ctx context.Context) (common.Address, error)
+	// Asset is the token deposits are made in and withdrawals paid out in.
+	Asset(ctx context.Context) (common.Address, error)
+	// PricePerShare is the value of one vault share in asset units.
+	PricePerShare(ctx context.Context) (*big.Int, error)
+	PreviewDeposit(ctx context.Context, assets *big.Int) (*big.Int, error)
+	// PreviewRedeem returns ErrUnsupported for ERC7540 forms, whose redemptions are asynchronous.
+	PreviewRedeem(ctx context.Context, shares *big.Int) (*big.Int, error)
+	VaultMetadata(ctx context.Context) (VaultMetadata, error)
+}
+
+// baseFormCaller is the BaseForm view surface the generated callers share.
+type baseFormCaller interface {
+	GetVaultAddress(opts *bind.CallOpts) (common.Address, error)
+	GetVaultAsset(opts *bind.CallOpts) (common.Address, error)
+	GetPricePerVaultShare(opts *bind.CallOpts) (*big.Int, error)
+	PreviewDepositTo(opts *bind.CallOpts, assets_ *big.Int) (*big.Int, error)
+	PreviewRedeemFrom(opts *bind.CallOpts, shares_ *big.Int) (*big.Int, error)
+	GetVaultName(opts *bind.CallOpts) (string, error)
+	GetVaultSymbol(opts *bind.CallOpts) (string, error)
+	GetVaultDecimals(opts *bind.CallOpts) (*big.Int, error)
+	GetTotalAssets(opts *bind.CallOpts) (*big.Int, error)
+	GetTotalSupply(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// base implements Form over a generated caller.
+type base struct {
+	kind    Kind
+	address common.Address
+	caller  baseFormCaller
+}
+
+func (b *base) Kind() Kind              { return b.kind }
+func (b *base) Address() common.Address { return b.address }
+
+func (b *base) Vault(ctx context.Context) (common.Address, error) {
+	return b.caller.GetVaultAddress(&bind.CallOpts{Context: ctx})
+}
+
+func (b *base) Asset(ctx context.Context) (common.Address, error) {
+	return b.caller.GetVaultAsset(&bind.CallOpts{Context: ctx})
+}
+
+func (b *base) PricePerShare(ctx context.Context) (*big.Int, error) {
+	return b.caller.GetPricePerVaultShare(&bind.CallOpts{Context: ctx})
+}
+
+func (b *base) PreviewDeposit(ctx context.Context, assets *big.Int) (*big.Int, error) {
+	return b.caller.PreviewDepositTo(&bind.CallOpts{Context: ctx}, assets)
+}
+
+func (b *base) PreviewRedeem(ctx context.Context, shares *big.Int) (*big.Int, error) {
+	return b.caller.PreviewRedeemFrom(&bind.CallOpts{Context: ctx}, shares)
+}
+
+func (b *base) VaultMetadata(ctx context.Context) (VaultMetadata, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	var (
+		md  VaultMetadata
+		err error
+	)
+	if md.Name, err = b.caller.GetVaultName(opts); err != nil {
+		return VaultMetadata{}, err
+	}
+	if md.Symbol, err = b.caller.GetVaultSymbol(opts); err != nil {
+		return VaultMetadata{}, err
+	}
+	decimals, err := b.caller.GetVaultDecimals(opts)
+	if err != nil {
+		return VaultMetadata{}, err
+	}
+	md.Decimals = uint8(decimals.Uint64())
+	if md.TotalAssets, err = b.caller.GetTotalAssets(opts); err != nil {
+		return VaultMetadata{}, err
+	}
+	if md.TotalSupply, err = b.caller.GetTotalSupply(opts); err != nil {
+		return VaultMetadata{}, err
+	}
+	return md, nil
+}
+
+// ERC4626Form is a superform over an ERC4626 vault.
+type ERC4626Form struct {
+	base
+	Caller *contracts.ERC4626FormCaller
+}
+
+// NewERC4626Form binds the ERC4626 superform at address.
+func NewERC4626Form(address common.Address, backend bind.ContractCaller) (*ERC4626Form, error) {
+	caller, err := contracts.NewERC4626FormCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626Form{base: base{kind: ERC4626, address: address, caller: caller}, Caller: caller}, nil
+}
+
+// ERC7540Form is a superform over an asynchronous ERC7540 vault; see pkg/async for its requests.
+type ERC7540Form struct {
+	base
+	Caller *contracts.ERC7540FormCaller
+}
+
+// NewERC7540Form binds the ERC7540 superform at address.
+func NewERC7540Form(address common.Address, backend bind.ContractCaller) (*ERC7540Form, error) {
+	caller, err := contracts.NewERC7540FormCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC7540Form{base: base{kind: ERC7540, address: address, caller: caller}, Caller: caller}, nil
+}
+
+// PreviewRedeem returns ErrUnsupported: ERC7540Form.previewRedeemFrom reverts with NOT_IMPLEMENTED.
+func (f *ERC7540Form) PreviewRedeem(ctx context.Context, shares *big.Int) (*big.Int, error) {
+	return nil, fmt.Errorf("%w: %s previewRedeemFrom", ErrUnsupported, f.kind)
+}
+
+// Open binds the superform of superformID, picking its implementation from kinds (ProductionKinds when nil).
+func Open(superformID *big.Int, backend bind.ContractCaller, kinds map[uint32]Kind) (Form, error) {
+	sf, err := datalib.GetSuperform(superformID)
+	if err != nil {
+		return nil, err
+	}
+	if kinds == nil {
+		kinds = ProductionKinds
+	}
+	switch kind := kinds[sf.FormImplementationID]; kind {
+	case ERC4626:
+		return NewERC4626Form(sf.Superform, backend)
+	case ERC5115:
+		return NewERC5115Form(sf.Superform, backend)
+	case ERC7540:
+		return NewERC7540Form(sf.Superform, backend)
+	default:
+		return nil, fmt.Errorf("%w: id %d", ErrUnknownKind, sf.FormImplementationID)
+	}
+}
+
+var (
+	_ Form = (*ERC4626Form)(nil)
+	_ Form = (*ERC5115Form)(nil)
+	_ Form = (*ERC7540Form)(nil)
+)
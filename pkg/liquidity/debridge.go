@@ -0,0 +1,389 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeBridgeError errors.
+var (
+	// ErrPermitEnvelope mirrors INVALID_PERMIT_ENVELOP.
+	ErrPermitEnvelope = errors.New("liquidity: permit envelope not allowed")
+	// ErrSwapPermitEnvelope mirrors INVALID_SWAP_PERMIT_ENVELOP.
+	ErrSwapPermitEnvelope = errors.New("liquidity: swap permit envelope not allowed")
+	// ErrDeBridgeAuthority mirrors INVALID_DEBRIDGE_AUTHORITY.
+	ErrDeBridgeAuthority = errors.New("liquidity: order authority is not the rescuer")
+	// ErrExtraCallData mirrors INVALID_EXTRA_CALL_DATA.
+	ErrExtraCallData = errors.New("liquidity: order external call not allowed")
+	// ErrTakerDst mirrors INVALID_TAKER_DST.
+	ErrTakerDst = errors.New("liquidity: allowed taker not allowed")
+	// ErrRefundAddress mirrors INVALID_REFUND_ADDRESS.
+	ErrRefundAddress = errors.New("liquidity: invalid refund address")
+	// ErrPatchAddress mirrors INVALID_PATCH_ADDRESS.
+	ErrPatchAddress = errors.New("liquidity: invalid patch authority")
+	// ErrBridgeData mirrors INVALID_BRIDGE_DATA.
+	ErrBridgeData = errors.New("liquidity: forwarder target is not DlnSource")
+	// ErrBridgeToken mirrors INVALID_BRIDGE_TOKEN.
+	ErrBridgeToken = errors.New("liquidity: swap output is not the order give token")
+	// ErrSwapRouter mirrors INVALID_SWAP_ROUTER.
+	ErrSwapRouter = errors.New("liquidity: unsupported forwarder swap router")
+)
+
+// DlnSource and CrossChainForwarder are the deBridge contract addresses DeBridgeForwarderValidator is built with.
+var (
+	DlnSource           = common.HexToAddress("0xeF4fB24aD0916217251F553c0596F8Edc630EB66")
+	CrossChainForwarder = common.HexToAddress("0x663DC15D3C1aC63ff12E45Ab68FeA3F0a883C251")
+)
+
+// crossChainForwarderABI is the slice of ICrossChainForwarder DeBridgeForwarderValidator reads.
+const crossChainForwarderABI = `[{"type":"function","name":"supportedRouters","inputs":[{"name":"router_","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"view"}]`
+
+const dlnOrderCreation = "(address,uint256,bytes,uint256,uint256,bytes,address,bytes,bytes,bytes,bytes)"
+
+var (
+	dlnCreateOrder       = selector("createOrder(" + dlnOrderCreation + ",bytes,uint32,bytes)")
+	dlnCreateSaltedOrder = selector("createSaltedOrder(" + dlnOrderCreation + ",uint64,bytes,uint32,bytes,bytes)")
+	strictlySwapAndCall  = selector("strictlySwapAndCall(address,uint256,bytes,address,bytes,address,uint256,address,address,bytes)")
+
+	dlnOrderCreationType = mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "giveTokenAddress", Type: "address"},
+		{Name: "giveAmount", Type: "uint256"},
+		{Name: "takeTokenAddress", Type: "bytes"},
+		{Name: "takeAmount", Type: "uint256"},
+		{Name: "takeChainId", Type: "uint256"},
+		{Name: "receiverDst", Type: "bytes"},
+		{Name: "givePatchAuthoritySrc", Type: "address"},
+		{Name: "orderAuthorityAddressDst", Type: "bytes"},
+		{Name: "allowedTakerDst", Type: "bytes"},
+		{Name: "externalCall", Type: "bytes"},
+		{Name: "allowedCancelBeneficiarySrc", Type: "bytes"},
+	})
+	dlnCreateOrderArgs = abi.Arguments{
+		{Type: dlnOrderCreationType},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("uint32", nil)},
+		{Type: mustType("bytes", nil)},
+	}
+	dlnCreateSaltedOrderArgs = abi.Arguments{
+		{Type: dlnOrderCreationType},
+		{Type: mustType("uint64", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("uint32", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("bytes", nil)},
+	}
+	strictlySwapAndCallArgs = abi.Arguments{
+		{Type: mustType("address", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("bytes", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("bytes", nil)},
+	}
+)
+
+// DlnOrder mirrors DlnOrderLib.OrderCreation. Destination chain addresses are bytes, EVM ones are 20 bytes.
+type DlnOrder struct {
+	GiveTokenAddress         common.Address
+	GiveAmount               *big.Int
+	TakeTokenAddress         []byte
+	TakeAmount               *big.Int
+	TakeChainId              *big.Int
+	ReceiverDst              []byte
+	GivePatchAuthoritySrc    common.Address
+	OrderAuthorityAddressDst []byte
+	AllowedTakerDst          []byte
+	// ExternalCall is the versioned envelope of a call made on the destination chain with the taken tokens. Both
+	// validators refuse orders with one.
+	ExternalCall                []byte
+	AllowedCancelBeneficiarySrc []byte
+}
+
+// DeBridgeQuote mirrors the DecodedQuote of DeBridgeForwarderValidator, the values both deBridge validators check.
+type DeBridgeQuote struct {
+	// InputToken and InputAmount are what txData spends: the order's give token, or the forwarder's swap input.
+	InputToken  common.Address
+	InputAmount *big.Int
+	// DstChainID is the order's take chain.
+	DstChainID   *big.Int
+	OutputToken  common.Address
+	OutputAmount *big.Int
+	// SwapRefundRecipient is the forwarder's swap refund recipient, the order's cancel beneficiary without a
+	// forwarder swap.
+	SwapRefundRecipient common.Address
+	// BridgeRefundRecipient is the order's cancel beneficiary.
+	BridgeRefundRecipient    common.Address
+	FinalReceiver            common.Address
+	GivePatchAuthoritySrc    common.Address
+	OrderAuthorityAddressDst common.Address
+}
+
+// Liquidity returns q normalized.
+func (q DeBridgeQuote) Liquidity() DecodedLiquidity {
+	return DecodedLiquidity{
+		InputToken:  q.InputToken,
+		OutputToken: q.OutputToken,
+		Amount:      q.InputAmount,
+		Receiver:    q.FinalReceiver,
+		DstChainID:  q.DstChainID,
+	}
+}
+
+// DeBridgeTx is a DlnSource createOrder or createSaltedOrder call.
+type DeBridgeTx struct {
+	Salted bool
+	Order  DlnOrder
+}
+
+// Quote returns the values DeBridgeValidator checks, with the zero give and take tokens mapped to Native.
+func (tx DeBridgeTx) Quote() DeBridgeQuote {
+	refund := castToAddress(tx.Order.AllowedCancelBeneficiarySrc)
+	return DeBridgeQuote{
+		InputToken:               native(tx.Order.GiveTokenAddress),
+		InputAmount:              tx.Order.GiveAmount,
+		DstChainID:               tx.Order.TakeChainId,
+		OutputToken:              native(castToAddress(tx.Order.TakeTokenAddress)),
+		OutputAmount:             tx.Order.TakeAmount,
+		SwapRefundRecipient:      refund,
+		BridgeRefundRecipient:    refund,
+		FinalReceiver:            castToAddress(tx.Order.ReceiverDst),
+		GivePatchAuthoritySrc:    tx.Order.GivePatchAuthoritySrc,
+		OrderAuthorityAddressDst: castToAddress(tx.Order.OrderAuthorityAddressDst),
+	}
+}
+
+// decodeDlnOrder decodes a createOrder or createSaltedOrder call and returns its permit envelope.
+func decodeDlnOrder(data []byte) (DeBridgeTx, []byte, error) {
+	if len(data) < 4 {
+		return DeBridgeTx{}, nil, fmt.Errorf("%w: %d bytes", ErrMalformed, len(data))
+	}
+	var sel [4]byte
+	copy(sel[:], data)
+	var (
+		tx     DeBridgeTx
+		args   abi.Arguments
+		permit int
+	)
+	switch sel {
+	case dlnCreateOrder:
+		args, permit = dlnCreateOrderArgs, 3
+	case dlnCreateSaltedOrder:
+		args, permit, tx.Salted = dlnCreateSaltedOrderArgs, 4, true
+	default:
+		return DeBridgeTx{}, nil, fmt.Errorf("%w: selector %x", ErrBlacklistedRouteID, sel)
+	}
+	out, err := args.Unpack(data[4:])
+	if err != nil {
+		return DeBridgeTx{}, nil, fmt.Errorf("%w: order: %v", ErrMalformed, err)
+	}
+	tx.Order = *abi.ConvertType(out[0], new(DlnOrder)).(*DlnOrder)
+	return tx, out[permit].([]byte), nil
+}
+
+// validateDeBridge applies the rules both deBridge validators share to q. Refunds and order patches must go to
+// args.ReceiverAddress, the destination order authority must be args.Rescuer and the order must spend args.Token
+// and be taken on args.LiqDstChainID. A deposit must be cross-chain, with args.DstChainID as the take chain, and
+// deliver to the CoreStateRegistry, or to the DstSwapper in args.InterimToken. A withdrawal must deliver to
+// args.ReceiverAddress.
+func validateDeBridge(args Args, q DeBridgeQuote) (bool, error) {
+	if q.BridgeRefundRecipient != args.ReceiverAddress || q.SwapRefundRecipient != args.ReceiverAddress {
+		return false, fmt.Errorf("%w: %s and %s, want %s", ErrRefundAddress, q.BridgeRefundRecipient, q.SwapRefundRecipient, args.ReceiverAddress)
+	}
+	if q.GivePatchAuthoritySrc != args.ReceiverAddress {
+		return false, fmt.Errorf("%w: %s, want %s", ErrPatchAddress, q.GivePatchAuthoritySrc, args.ReceiverAddress)
+	}
+	if q.OrderAuthorityAddressDst != args.Rescuer {
+		return false, fmt.Errorf("%w: %s, want %s", ErrDeBridgeAuthority, q.OrderAuthorityAddressDst, args.Rescuer)
+	}
+	// Like the validators, only the low 64 bits of the take chain id are compared and a wrong token is reported
+	// as a wrong chain.
+	takeChainID := new(big.Int).And(q.DstChainID, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	if takeChainID != args.LiqDstChainID || q.InputToken != args.Token {
+		return false, fmt.Errorf("%w: %s to %s, want %s to %d", ErrChainID, q.InputToken, q.DstChainID, args.Token, args.LiqDstChainID)
+	}
+	if !args.Deposit {
+		if q.FinalReceiver != args.ReceiverAddress {
+			return false, fmt.Errorf("%w: %s, want %s", ErrReceiver, q.FinalReceiver, args.ReceiverAddress)
+		}
+		return false, nil
+	}
+	if args.SrcChainID == args.DstChainID || args.DstChainID != args.LiqDstChainID {
+		return false, fmt.Errorf("%w: deposit from %d to %d, liquidity to %d", ErrInvalidAction, args.SrcChainID, args.DstChainID, args.LiqDstChainID)
+	}
+	hasDstSwap := q.FinalReceiver == args.DstSwapper
+	if q.FinalReceiver != args.CoreStateRegistry && !hasDstSwap {
+		return false, fmt.Errorf("%w: %s is neither CoreStateRegistry nor DstSwapper on %d", ErrReceiver, q.FinalReceiver, args.DstChainID)
+	}
+	if hasDstSwap && args.InterimToken != q.OutputToken {
+		return false, fmt.Errorf("%w: %s, order takes %s", ErrInterimToken, args.InterimToken, q.OutputToken)
+	}
+	return hasDstSwap, nil
+}
+
+// DeBridge decodes and validates DLN order creation calldata the way DeBridgeValidator does.
+type DeBridge struct{}
+
+// Decode decodes txData, refusing a permit envelope.
+func (DeBridge) Decode(txData []byte) (DeBridgeTx, error) {
+	tx, permit, err := decodeDlnOrder(txData)
+	if err != nil {
+		return DeBridgeTx{}, err
+	}
+	if len(permit) > 0 {
+		return DeBridgeTx{}, ErrPermitEnvelope
+	}
+	return tx, nil
+}
+
+// Liquidity decodes txData into a DecodedLiquidity.
+func (d DeBridge) Liquidity(txData []byte) (DecodedLiquidity, error) {
+	tx, err := d.Decode(txData)
+	if err != nil {
+		return DecodedLiquidity{}, err
+	}
+	return tx.Quote().Liquidity(), nil
+}
+
+// Validate checks args.TxData like DeBridgeValidator.validateTxData: the order must have no external call and no
+// allowed taker, then the deBridge rules apply.
+func (d DeBridge) Validate(args Args) (bool, error) {
+	tx, err := d.Decode(args.TxData)
+	if err != nil {
+		return false, err
+	}
+	if len(tx.Order.ExternalCall) > 0 {
+		return false, ErrExtraCallData
+	}
+	if len(tx.Order.AllowedTakerDst) > 0 {
+		return false, ErrTakerDst
+	}
+	return validateDeBridge(args, tx.Quote())
+}
+
+// DeBridgeForwarderTx is a CrossChainForwarder strictlySwapAndCall: a source chain swap whose output funds the DLN
+// order in TargetData.
+type DeBridgeForwarderTx struct {
+	SrcTokenIn              common.Address
+	SrcAmountIn             *big.Int
+	SrcSwapRouter           common.Address
+	SrcSwapCalldata         []byte
+	SrcTokenOut             common.Address
+	SrcTokenExpectedOut     *big.Int
+	SrcTokenRefundRecipient common.Address
+	Target                  common.Address
+	// Order is the DLN order TargetData creates.
+	Order DeBridgeTx
+}
+
+// Quote returns the values DeBridgeForwarderValidator checks, with the zero input and take tokens mapped to
+// Native.
+func (tx DeBridgeForwarderTx) Quote() DeBridgeQuote {
+	q := tx.Order.Quote()
+	q.InputToken, q.InputAmount = native(tx.SrcTokenIn), tx.SrcAmountIn
+	q.SwapRefundRecipient = tx.SrcTokenRefundRecipient
+	return q
+}
+
+// CheckSwapRouter checks with the forwarder that the swap router is supported, which DeBridgeForwarderValidator
+// does on chain while decoding.
+func (tx DeBridgeForwarderTx) CheckSwapRouter(ctx context.Context, caller bind.ContractCaller) error {
+	parsed, err := abi.JSON(strings.NewReader(crossChainForwarderABI))
+	if err != nil {
+		return err
+	}
+	forwarder := bind.NewBoundContract(CrossChainForwarder, parsed, caller, nil, nil)
+	var out []interface{}
+	if err := forwarder.Call(&bind.CallOpts{Context: ctx}, &out, "supportedRouters", tx.SrcSwapRouter); err != nil {
+		return fmt.Errorf("liquidity: forwarder supportedRouters: %w", err)
+	}
+	if !*abi.ConvertType(out[0], new(bool)).(*bool) {
+		return fmt.Errorf("%w: %s", ErrSwapRouter, tx.SrcSwapRouter)
+	}
+	return nil
+}
+
+// DeBridgeForwarder decodes and validates CrossChainForwarder calldata the way DeBridgeForwarderValidator does.
+// Decoding is offline, so the swap router check is left to DeBridgeForwarderTx.CheckSwapRouter.
+type DeBridgeForwarder struct{}
+
+// Decode decodes txData. The swap must have no permit envelope and fund a DlnSource order of its output token
+// with neither a permit envelope, an external call nor an allowed taker.
+func (DeBridgeForwarder) Decode(txData []byte) (DeBridgeForwarderTx, error) {
+	if len(txData) < 4 {
+		return DeBridgeForwarderTx{}, fmt.Errorf("%w: %d bytes", ErrMalformed, len(txData))
+	}
+	var sel [4]byte
+	copy(sel[:], txData)
+	if sel != strictlySwapAndCall {
+		return DeBridgeForwarderTx{}, fmt.Errorf("%w: selector %x", ErrBlacklistedRouteID, sel)
+	}
+	out, err := strictlySwapAndCallArgs.Unpack(txData[4:])
+	if err != nil {
+		return DeBridgeForwarderTx{}, fmt.Errorf("%w: strictlySwapAndCall: %v", ErrMalformed, err)
+	}
+	if len(out[2].([]byte)) > 0 {
+		return DeBridgeForwarderTx{}, ErrSwapPermitEnvelope
+	}
+	tx := DeBridgeForwarderTx{
+		SrcTokenIn:              out[0].(common.Address),
+		SrcAmountIn:             out[1].(*big.Int),
+		SrcSwapRouter:           out[3].(common.Address),
+		SrcSwapCalldata:         out[4].([]byte),
+		SrcTokenOut:             out[5].(common.Address),
+		SrcTokenExpectedOut:     out[6].(*big.Int),
+		SrcTokenRefundRecipient: out[7].(common.Address),
+		Target:                  out[8].(common.Address),
+	}
+	targetData := out[9].([]byte)
+	if len(targetData) == 0 || tx.Target != DlnSource {
+		return DeBridgeForwarderTx{}, fmt.Errorf("%w: target %s", ErrBridgeData, tx.Target)
+	}
+	order, permit, err := decodeDlnOrder(targetData)
+	if err != nil {
+		return DeBridgeForwarderTx{}, err
+	}
+	if tx.SrcTokenOut != order.Order.GiveTokenAddress {
+		return DeBridgeForwarderTx{}, fmt.Errorf("%w: %s, order gives %s", ErrBridgeToken, tx.SrcTokenOut, order.Order.GiveTokenAddress)
+	}
+	if len(permit) > 0 {
+		return DeBridgeForwarderTx{}, ErrPermitEnvelope
+	}
+	if len(order.Order.ExternalCall) > 0 {
+		return DeBridgeForwarderTx{}, ErrExtraCallData
+	}
+	if len(order.Order.AllowedTakerDst) > 0 {
+		return DeBridgeForwarderTx{}, ErrTakerDst
+	}
+	tx.Order = order
+	return tx, nil
+}
+
+// Liquidity decodes txData into a DecodedLiquidity.
+func (f DeBridgeForwarder) Liquidity(txData []byte) (DecodedLiquidity, error) {
+	tx, err := f.Decode(txData)
+	if err != nil {
+		return DecodedLiquidity{}, err
+	}
+	return tx.Quote().Liquidity(), nil
+}
+
+// Validate checks args.TxData like DeBridgeForwarderValidator.validateTxData, except for the swap router.
+func (f DeBridgeForwarder) Validate(args Args) (bool, error) {
+	tx, err := f.Decode(args.TxData)
+	if err != nil {
+		return false, err
+	}
+	return validateDeBridge(args, tx.Quote())
+}
@@ -0,0 +1,303 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var testRescuer = common.HexToAddress("0x6666666666666666666666666666666666666666")
+
+// testOrder is a deposit order from testUser that depositArgs accept, taken as testInterim by the
+// CoreStateRegistry.
+func testOrder() DlnOrder {
+	return DlnOrder{
+		GiveTokenAddress:            testToken,
+		GiveAmount:                  big.NewInt(1000),
+		TakeTokenAddress:            testInterim.Bytes(),
+		TakeAmount:                  big.NewInt(990),
+		TakeChainId:                 big.NewInt(8453),
+		ReceiverDst:                 testRegistry.Bytes(),
+		GivePatchAuthoritySrc:       testUser,
+		OrderAuthorityAddressDst:    testRescuer.Bytes(),
+		AllowedTakerDst:             []byte{},
+		ExternalCall:                []byte{},
+		AllowedCancelBeneficiarySrc: testUser.Bytes(),
+	}
+}
+
+func createOrder(t *testing.T, order DlnOrder, permit []byte) []byte {
+	return pack(t, dlnCreateOrder, dlnCreateOrderArgs, order, []byte{}, uint32(0), permit)
+}
+
+func createSaltedOrder(t *testing.T, order DlnOrder, permit []byte) []byte {
+	return pack(t, dlnCreateSaltedOrder, dlnCreateSaltedOrderArgs, order, uint64(42), []byte{}, uint32(0), permit, []byte{})
+}
+
+// forwarderTx is a strictlySwapAndCall of testInterim into targetData, refunding the swap to refund.
+func forwarderTx(t *testing.T, permit []byte, tokenOut, refund, target common.Address, targetData []byte) []byte {
+	return pack(t, strictlySwapAndCall, strictlySwapAndCallArgs,
+		testInterim, big.NewInt(2000), permit, testRouter, []byte{0x01}, tokenOut, big.NewInt(1000), refund, target, targetData)
+}
+
+func deBridgeArgs() Args {
+	args := depositArgs(nil)
+	args.Rescuer = testRescuer
+	return args
+}
+
+func TestDeBridgeDecode(t *testing.T) {
+	nativeOrder := testOrder()
+	nativeOrder.GiveTokenAddress = common.Address{}
+	nativeOrder.TakeTokenAddress = []byte{}
+	wantQuote := DeBridgeQuote{
+		InputToken:               testToken,
+		InputAmount:              big.NewInt(1000),
+		DstChainID:               big.NewInt(8453),
+		OutputToken:              testInterim,
+		OutputAmount:             big.NewInt(990),
+		SwapRefundRecipient:      testUser,
+		BridgeRefundRecipient:    testUser,
+		FinalReceiver:            testRegistry,
+		GivePatchAuthoritySrc:    testUser,
+		OrderAuthorityAddressDst: testRescuer,
+	}
+	wantNative := wantQuote
+	wantNative.InputToken, wantNative.OutputToken = Native, Native
+
+	tests := []struct {
+		name       string
+		txData     []byte
+		wantSalted bool
+		want       DeBridgeQuote
+		wantErr    error
+	}{
+		{name: "createOrder", txData: createOrder(t, testOrder(), []byte{}), want: wantQuote},
+		{name: "createSaltedOrder", txData: createSaltedOrder(t, testOrder(), []byte{}), wantSalted: true, want: wantQuote},
+		{name: "native tokens", txData: createOrder(t, nativeOrder, []byte{}), want: wantNative},
+		{name: "permit envelope", txData: createOrder(t, testOrder(), []byte{0x01}), wantErr: ErrPermitEnvelope},
+		{name: "salted permit envelope", txData: createSaltedOrder(t, testOrder(), []byte{0x01}), wantErr: ErrPermitEnvelope},
+		{name: "unknown selector", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, []byte{0x01}), wantErr: ErrBlacklistedRouteID},
+		{name: "truncated", txData: append(dlnCreateOrder[:], make([]byte, 64)...), wantErr: ErrMalformed},
+		{name: "too short", txData: []byte{0x01}, wantErr: ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := DeBridge{}.Decode(tt.txData)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tx.Salted != tt.wantSalted {
+				t.Fatalf("salted %v, want %v", tx.Salted, tt.wantSalted)
+			}
+			checkQuote(t, tx.Quote(), tt.want)
+		})
+	}
+}
+
+func checkQuote(t *testing.T, got, want DeBridgeQuote) {
+	t.Helper()
+	if got.InputAmount.Cmp(want.InputAmount) != 0 || got.OutputAmount.Cmp(want.OutputAmount) != 0 || got.DstChainID.Cmp(want.DstChainID) != 0 {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	got.InputAmount, got.OutputAmount, got.DstChainID = want.InputAmount, want.OutputAmount, want.DstChainID
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+// deBridgeRules break the order rules DeBridge.Validate checks, in the order it checks them.
+var deBridgeRules = []struct {
+	name    string
+	breakIt func(*DlnOrder)
+	wantErr error
+}{
+	{"external call", func(o *DlnOrder) { o.ExternalCall = []byte{0x01} }, ErrExtraCallData},
+	{"allowed taker", func(o *DlnOrder) { o.AllowedTakerDst = testUser.Bytes() }, ErrTakerDst},
+	{"refund address", func(o *DlnOrder) { o.AllowedCancelBeneficiarySrc = testRouter.Bytes() }, ErrRefundAddress},
+	{"patch authority", func(o *DlnOrder) { o.GivePatchAuthoritySrc = testRouter }, ErrPatchAddress},
+	{"order authority", func(o *DlnOrder) { o.OrderAuthorityAddressDst = testUser.Bytes() }, ErrDeBridgeAuthority},
+	{"take chain", func(o *DlnOrder) { o.TakeChainId = big.NewInt(1) }, ErrChainID},
+	{"give token", func(o *DlnOrder) { o.GiveTokenAddress = testInterim }, ErrChainID},
+	{"receiver", func(o *DlnOrder) { o.ReceiverDst = testUser.Bytes() }, ErrReceiver},
+}
+
+func TestDeBridgeValidate(t *testing.T) {
+	// Each case breaks its rule and every later one, so the first failing rule is the one reported.
+	for i, rule := range deBridgeRules {
+		t.Run(rule.name, func(t *testing.T) {
+			order := testOrder()
+			for _, later := range deBridgeRules[i:] {
+				later.breakIt(&order)
+			}
+			args := deBridgeArgs()
+			args.TxData = createOrder(t, order, []byte{})
+			if _, err := (DeBridge{}).Validate(args); !errors.Is(err, rule.wantErr) {
+				t.Fatalf("err %v, want %v", err, rule.wantErr)
+			}
+		})
+	}
+
+	toSwapper := testOrder()
+	toSwapper.ReceiverDst = testSwapper.Bytes()
+	withdrawal := deBridgeArgs()
+	withdrawal.Deposit = false
+	sameChain := deBridgeArgs()
+	sameChain.DstChainID = 10
+	interim := deBridgeArgs()
+	interim.InterimToken = testInterim
+	wrongInterim := deBridgeArgs()
+	wrongInterim.InterimToken = testToken
+	toUser := testOrder()
+	toUser.ReceiverDst = testUser.Bytes()
+
+	tests := []struct {
+		name        string
+		args        Args
+		order       DlnOrder
+		wantErr     error
+		wantDstSwap bool
+	}{
+		{name: "deposit", args: deBridgeArgs(), order: testOrder()},
+		{name: "same chain deposit", args: sameChain, order: testOrder(), wantErr: ErrInvalidAction},
+		{name: "dst swap interim token", args: wrongInterim, order: toSwapper, wantErr: ErrInterimToken},
+		{name: "dst swap", args: interim, order: toSwapper, wantDstSwap: true},
+		{name: "withdrawal receiver", args: withdrawal, order: testOrder(), wantErr: ErrReceiver},
+		{name: "withdrawal", args: withdrawal, order: toUser},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			args.TxData = createSaltedOrder(t, tt.order, []byte{})
+			hasDstSwap, err := DeBridge{}.Validate(args)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			if hasDstSwap != tt.wantDstSwap {
+				t.Fatalf("hasDstSwap %v, want %v", hasDstSwap, tt.wantDstSwap)
+			}
+		})
+	}
+}
+
+func TestDeBridgeForwarderDecode(t *testing.T) {
+	order := createOrder(t, testOrder(), []byte{})
+	withCall := testOrder()
+	withCall.ExternalCall = []byte{0x01}
+	withTaker := testOrder()
+	withTaker.AllowedTakerDst = testUser.Bytes()
+
+	tests := []struct {
+		name    string
+		txData  []byte
+		wantErr error
+	}{
+		{name: "createOrder", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, order)},
+		{name: "createSaltedOrder", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, createSaltedOrder(t, testOrder(), []byte{}))},
+		{name: "swap permit before target", txData: forwarderTx(t, []byte{0x01}, testToken, testUser, testRouter, order), wantErr: ErrSwapPermitEnvelope},
+		{name: "target", txData: forwarderTx(t, []byte{}, testToken, testUser, testRouter, order), wantErr: ErrBridgeData},
+		{name: "empty target data", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, []byte{}), wantErr: ErrBridgeData},
+		{name: "target selector", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, append(strictlySwapAndCall[:], order[4:]...)), wantErr: ErrBlacklistedRouteID},
+		{name: "bridge token before permit", txData: forwarderTx(t, []byte{}, testInterim, testUser, DlnSource, createOrder(t, testOrder(), []byte{0x01})), wantErr: ErrBridgeToken},
+		{name: "order permit", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, createOrder(t, testOrder(), []byte{0x01})), wantErr: ErrPermitEnvelope},
+		{name: "external call", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, createOrder(t, withCall, []byte{})), wantErr: ErrExtraCallData},
+		{name: "allowed taker", txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, createOrder(t, withTaker, []byte{})), wantErr: ErrTakerDst},
+		{name: "selector", txData: order, wantErr: ErrBlacklistedRouteID},
+		{name: "too short", txData: []byte{0x01}, wantErr: ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := DeBridgeForwarder{}.Decode(tt.txData)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tx.SrcSwapRouter != testRouter || tx.Target != DlnSource || tx.SrcTokenOut != testToken {
+				t.Fatalf("got %+v", tx)
+			}
+			checkQuote(t, tx.Quote(), DeBridgeQuote{
+				InputToken:               testInterim,
+				InputAmount:              big.NewInt(2000),
+				DstChainID:               big.NewInt(8453),
+				OutputToken:              testInterim,
+				OutputAmount:             big.NewInt(990),
+				SwapRefundRecipient:      testUser,
+				BridgeRefundRecipient:    testUser,
+				FinalReceiver:            testRegistry,
+				GivePatchAuthoritySrc:    testUser,
+				OrderAuthorityAddressDst: testRescuer,
+			})
+		})
+	}
+}
+
+func TestDeBridgeForwarderValidate(t *testing.T) {
+	order := createOrder(t, testOrder(), []byte{})
+	args := deBridgeArgs()
+	args.Token = testInterim
+
+	tests := []struct {
+		name    string
+		args    Args
+		txData  []byte
+		wantErr error
+	}{
+		{name: "swap refund recipient", args: args, txData: forwarderTx(t, []byte{}, testToken, testRouter, DlnSource, order), wantErr: ErrRefundAddress},
+		{name: "swap input is the token", args: deBridgeArgs(), txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, order), wantErr: ErrChainID},
+		{name: "deposit", args: args, txData: forwarderTx(t, []byte{}, testToken, testUser, DlnSource, order)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			args.TxData = tt.txData
+			if _, err := (DeBridgeForwarder{}).Validate(args); !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// forwarderCaller answers supportedRouters with supported.
+type forwarderCaller struct {
+	supported bool
+}
+
+func (forwarderCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (c forwarderCaller) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	out := make([]byte, 32)
+	if c.supported {
+		out[31] = 1
+	}
+	return out, nil
+}
+
+func TestDeBridgeForwarderCheckSwapRouter(t *testing.T) {
+	tx, err := DeBridgeForwarder{}.Decode(forwarderTx(t, []byte{}, testToken, testUser, DlnSource, createOrder(t, testOrder(), []byte{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.CheckSwapRouter(context.Background(), forwarderCaller{supported: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.CheckSwapRouter(context.Background(), forwarderCaller{}); !errors.Is(err, ErrSwapRouter) {
+		t.Fatalf("err %v, want %v", err, ErrSwapRouter)
+	}
+}
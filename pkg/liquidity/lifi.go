@@ -0,0 +1,460 @@
+package liquidity
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Canonical types of the LiFi structs, for selectors.
+const (
+	lifiBridgeData = "(bytes32,string,string,address,address,address,uint256,uint256,bool,bool)"
+	lifiSwapData   = "(address,address,address,address,uint256,bytes,bool)[]"
+	lifiHopData    = "(uint256,uint256,uint256,uint256,uint256,address,uint256,uint256)"
+	lifiHopDataOpt = "(uint256,uint256,uint256,uint256,uint256,address,address,uint256,uint256)"
+	lifiAmarokData = "(bytes,address,uint256,uint256,address,uint32,bool)"
+	lifiStargate   = "(uint256,uint256,uint256,uint256,uint256,address,bytes,bytes)"
+	lifiCelerIM    = "(uint32,uint64,bytes,bytes,uint256,uint8)"
+)
+
+func lifiSelector(name string, types ...string) [4]byte {
+	return selector(name + "(" + strings.Join(types, ",") + ")")
+}
+
+var (
+	lifiStandardizedCall  = lifiSelector("standardizedCall", "bytes")
+	lifiSwapTokensGeneric = lifiSelector("swapTokensGeneric", "bytes32", "string", "string", "address", "uint256", lifiSwapData)
+
+	lifiAmarok            = lifiSelector("startBridgeTokensViaAmarok", lifiBridgeData, lifiAmarokData)
+	lifiSwapAmarok        = lifiSelector("swapAndStartBridgeTokensViaAmarok", lifiBridgeData, lifiSwapData, lifiAmarokData)
+	lifiStargateBridge    = lifiSelector("startBridgeTokensViaStargate", lifiBridgeData, lifiStargate)
+	lifiSwapStargate      = lifiSelector("swapAndStartBridgeTokensViaStargate", lifiBridgeData, lifiSwapData, lifiStargate)
+	lifiCelerIMBridge     = lifiSelector("startBridgeTokensViaCelerIM", lifiBridgeData, lifiCelerIM)
+	lifiSwapCelerIMBridge = lifiSelector("swapAndStartBridgeTokensViaCelerIM", lifiBridgeData, lifiSwapData, lifiCelerIM)
+)
+
+// LiFiBlacklist is the selectors the LiFiValidator constructor blacklists: the packed CBridge and Hop facets,
+// whose calldata does not carry a BridgeData, every Hop and Amarok facet and standardizedCall.
+var LiFiBlacklist = [][4]byte{
+	lifiSelector("startBridgeTokensViaCBridgeNativePacked"),
+	lifiSelector("startBridgeTokensViaCBridgeNativeMin", "bytes32", "address", "uint64", "uint64", "uint32"),
+	lifiSelector("startBridgeTokensViaCBridgeERC20Packed"),
+	lifiSelector("startBridgeTokensViaCBridgeERC20Min", "bytes32", "address", "uint64", "address", "uint256", "uint64", "uint32"),
+	lifiSelector("startBridgeTokensViaHopL2NativePacked"),
+	lifiSelector("startBridgeTokensViaHopL2NativeMin", "bytes8", "address", "uint256", "uint256", "uint256", "uint256", "uint256", "address"),
+	lifiSelector("startBridgeTokensViaHopL2ERC20Packed"),
+	lifiSelector("startBridgeTokensViaHopL2ERC20Min", "bytes8", "address", "uint256", "address", "uint256", "uint256", "uint256", "uint256", "uint256", "address"),
+	lifiSelector("startBridgeTokensViaHopL1NativePacked"),
+	lifiSelector("startBridgeTokensViaHopL1NativeMin", "bytes8", "address", "uint256", "uint256", "address", "uint256", "address"),
+	lifiSelector("startBridgeTokensViaHopL1ERC20Packed"),
+	lifiSelector("startBridgeTokensViaHopL1ERC20Min", "bytes8", "address", "uint256", "address", "uint256", "uint256", "address", "uint256", "address"),
+	lifiSelector("startBridgeTokensViaHop", lifiBridgeData, lifiHopData),
+	lifiSelector("swapAndStartBridgeTokensViaHop", lifiBridgeData, lifiSwapData, lifiHopData),
+	lifiSelector("startBridgeTokensViaHopL1ERC20", lifiBridgeData, lifiHopDataOpt),
+	lifiSelector("startBridgeTokensViaHopL1Native", lifiBridgeData, lifiHopDataOpt),
+	lifiSelector("swapAndStartBridgeTokensViaHopL1ERC20", lifiBridgeData, lifiSwapData, lifiHopDataOpt),
+	lifiSelector("swapAndStartBridgeTokensViaHopL1Native", lifiBridgeData, lifiSwapData, lifiHopDataOpt),
+	lifiSelector("startBridgeTokensViaHopL2ERC20", lifiBridgeData, lifiHopDataOpt),
+	lifiSelector("startBridgeTokensViaHopL2Native", lifiBridgeData, lifiHopDataOpt),
+	lifiSelector("swapAndStartBridgeTokensViaHopL2ERC20", lifiBridgeData, lifiSwapData, lifiHopDataOpt),
+	lifiSelector("swapAndStartBridgeTokensViaHopL2Native", lifiBridgeData, lifiSwapData, lifiHopDataOpt),
+	lifiAmarok,
+	lifiSwapAmarok,
+	lifiStandardizedCall,
+}
+
+var (
+	bridgeDataType = mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "transactionId", Type: "bytes32"},
+		{Name: "bridge", Type: "string"},
+		{Name: "integrator", Type: "string"},
+		{Name: "referrer", Type: "address"},
+		{Name: "sendingAssetId", Type: "address"},
+		{Name: "receiver", Type: "address"},
+		{Name: "minAmount", Type: "uint256"},
+		{Name: "destinationChainId", Type: "uint256"},
+		{Name: "hasSourceSwaps", Type: "bool"},
+		{Name: "hasDestinationCall", Type: "bool"},
+	})
+	swapDataType = mustType("tuple[]", []abi.ArgumentMarshaling{
+		{Name: "callTo", Type: "address"},
+		{Name: "approveTo", Type: "address"},
+		{Name: "sendingAssetId", Type: "address"},
+		{Name: "receivingAssetId", Type: "address"},
+		{Name: "fromAmount", Type: "uint256"},
+		{Name: "callData", Type: "bytes"},
+		{Name: "requiresDeposit", Type: "bool"},
+	})
+	amarokDataType = mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "callData", Type: "bytes"},
+		{Name: "callTo", Type: "address"},
+		{Name: "relayerFee", Type: "uint256"},
+		{Name: "slippageTol", Type: "uint256"},
+		{Name: "delegate", Type: "address"},
+		{Name: "destChainDomainId", Type: "uint32"},
+		{Name: "payFeeWithSendingAsset", Type: "bool"},
+	})
+	stargateDataType = mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "srcPoolId", Type: "uint256"},
+		{Name: "dstPoolId", Type: "uint256"},
+		{Name: "minAmountLD", Type: "uint256"},
+		{Name: "dstGasForCall", Type: "uint256"},
+		{Name: "lzFee", Type: "uint256"},
+		{Name: "refundAddress", Type: "address"},
+		{Name: "callTo", Type: "bytes"},
+		{Name: "callData", Type: "bytes"},
+	})
+	celerIMDataType = mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "maxSlippage", Type: "uint32"},
+		{Name: "nonce", Type: "uint64"},
+		{Name: "callTo", Type: "bytes"},
+		{Name: "callData", Type: "bytes"},
+		{Name: "messageBusFee", Type: "uint256"},
+		{Name: "bridgeType", Type: "uint8"},
+	})
+
+	lifiBytesArgs      = abi.Arguments{{Type: mustType("bytes", nil)}}
+	lifiBridgeArgs     = abi.Arguments{{Type: bridgeDataType}}
+	lifiSwapBridgeArgs = abi.Arguments{{Type: bridgeDataType}, {Type: swapDataType}}
+	lifiGenericArgs    = abi.Arguments{
+		{Type: mustType("bytes32", nil)},
+		{Type: mustType("string", nil)},
+		{Type: mustType("string", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: swapDataType},
+	}
+)
+
+// bridgeData mirrors ILiFi.BridgeData.
+type bridgeData struct {
+	TransactionId      [32]byte
+	Bridge             string
+	Integrator         string
+	Referrer           common.Address
+	SendingAssetId     common.Address
+	Receiver           common.Address
+	MinAmount          *big.Int
+	DestinationChainId *big.Int
+	HasSourceSwaps     bool
+	HasDestinationCall bool
+}
+
+// swapData mirrors LibSwap.SwapData.
+type swapData struct {
+	CallTo           common.Address
+	ApproveTo        common.Address
+	SendingAssetId   common.Address
+	ReceivingAssetId common.Address
+	FromAmount       *big.Int
+	CallData         []byte
+	RequiresDeposit  bool
+}
+
+// amarokData mirrors AmarokFacet.AmarokData.
+type amarokData struct {
+	CallData               []byte
+	CallTo                 common.Address
+	RelayerFee             *big.Int
+	SlippageTol            *big.Int
+	Delegate               common.Address
+	DestChainDomainId      uint32
+	PayFeeWithSendingAsset bool
+}
+
+// stargateData mirrors StargateFacet.StargateData.
+type stargateData struct {
+	SrcPoolId     *big.Int
+	DstPoolId     *big.Int
+	MinAmountLD   *big.Int
+	DstGasForCall *big.Int
+	LzFee         *big.Int
+	RefundAddress common.Address
+	CallTo        []byte
+	CallData      []byte
+}
+
+// LiFiTx is LiFi diamond calldata as LiFiValidator reads it.
+type LiFiTx struct {
+	// Selector is the selector LiFiValidator dispatches on and checks against its blacklist. As on chain, it is
+	// the four bytes after the standardizedCall selector for a wrapped call, which are the zero high bytes of the
+	// bytes offset, so a wrapped call is never blacklisted and never takes the generic swap path.
+	Selector [4]byte
+	// Generic is set for swapTokensGeneric, a same chain swap.
+	Generic bool
+	// Bridge is BridgeData.bridge, empty for a generic swap.
+	Bridge string
+	// Token is the first swap's sending asset, or BridgeData.sendingAssetId without source swaps. The zero
+	// address LiFi uses for the native token is mapped to Native.
+	Token common.Address
+	// Amount is the amount taken from the caller: the first swap's fromAmount, or BridgeData.minAmount.
+	Amount *big.Int
+	// MinAmount is BridgeData.minAmount, or the minimum output of a generic swap.
+	MinAmount *big.Int
+	Receiver  common.Address
+	// OutputToken is the last swap's receiving asset of a generic swap.
+	OutputToken common.Address
+	// DstChainID is BridgeData.destinationChainId, nil for a generic swap.
+	DstChainID         *big.Int
+	HasSourceSwaps     bool
+	HasDestinationCall bool
+}
+
+// Liquidity returns tx normalized. OutputToken is only known for a generic swap.
+func (tx LiFiTx) Liquidity() DecodedLiquidity {
+	return DecodedLiquidity{
+		InputToken:  tx.Token,
+		OutputToken: tx.OutputToken,
+		Amount:      tx.Amount,
+		Receiver:    tx.Receiver,
+		DstChainID:  tx.DstChainID,
+	}
+}
+
+// LiFi decodes and validates txData the way LiFiValidator does.
+type LiFi struct {
+	blacklist map[[4]byte]bool
+}
+
+// NewLiFi creates a LiFi with the LiFiBlacklist selectors blacklisted. Blacklist and Unblacklist follow the
+// emergency admin's addToBlacklist and removeFromBlacklist calls on the deployed validator.
+func NewLiFi() *LiFi {
+	l := &LiFi{blacklist: make(map[[4]byte]bool, len(LiFiBlacklist))}
+	for _, s := range LiFiBlacklist {
+		l.blacklist[s] = true
+	}
+	return l
+}
+
+// Blacklist blacklists selector.
+func (l *LiFi) Blacklist(selector [4]byte) {
+	l.blacklist[selector] = true
+}
+
+// Unblacklist removes selector from the blacklist.
+func (l *LiFi) Unblacklist(selector [4]byte) {
+	delete(l.blacklist, selector)
+}
+
+// Blacklisted reports whether selector is blacklisted.
+func (l *LiFi) Blacklisted(selector [4]byte) bool {
+	return l.blacklist[selector]
+}
+
+// Decode decodes txData, refusing blacklisted selectors.
+func (l *LiFi) Decode(txData []byte) (LiFiTx, error) {
+	if len(txData) < 4 {
+		return LiFiTx{}, fmt.Errorf("%w: %d bytes", ErrMalformed, len(txData))
+	}
+	sel := lifiExtractSelector(txData)
+	if l.Blacklisted(sel) {
+		return LiFiTx{}, fmt.Errorf("%w: %x", ErrBlacklistedSelector, sel)
+	}
+	if sel == lifiSwapTokensGeneric {
+		return decodeLiFiGeneric(txData)
+	}
+	return decodeLiFiBridge(txData)
+}
+
+// Validate checks args.TxData like LiFiValidator.validateTxData and returns whether a deposit bridges to the
+// DstSwapper, i.e. has a dst swap.
+//
+// A generic swap must be a same chain deposit into args.Superform or a withdrawal to args.ReceiverAddress. A
+// bridge must have no destination call and go to args.LiqDstChainID, and either be a cross-chain deposit to the
+// CoreStateRegistry or DstSwapper, the latter requiring an interim token, or a withdrawal to
+// args.ReceiverAddress. Both must spend args.Token.
+func (l *LiFi) Validate(args Args) (bool, error) {
+	tx, err := l.Decode(args.TxData)
+	if err != nil {
+		return false, err
+	}
+	if tx.Generic {
+		if err := validateSwap(args, tx.Receiver); err != nil {
+			return false, err
+		}
+		return false, checkToken(tx.Token, args.Token)
+	}
+	if tx.HasDestinationCall {
+		return false, ErrDestinationCall
+	}
+	hasDstSwap, err := validateBridge(args, tx.Receiver, tx.DstChainID)
+	if err != nil {
+		return false, err
+	}
+	return hasDstSwap, checkToken(tx.Token, args.Token)
+}
+
+// Liquidity decodes txData into a DecodedLiquidity.
+func (l *LiFi) Liquidity(txData []byte) (DecodedLiquidity, error) {
+	tx, err := l.Decode(txData)
+	if err != nil {
+		return DecodedLiquidity{}, err
+	}
+	return tx.Liquidity(), nil
+}
+
+// DstSwap returns the token and amount a generic swap spends, like LiFiValidator.decodeDstSwap, which checks no
+// blacklist. Bridge calldata is refused with ErrInvalidAction.
+func (l *LiFi) DstSwap(txData []byte) (common.Address, *big.Int, error) {
+	if len(txData) < 4 {
+		return common.Address{}, nil, fmt.Errorf("%w: %d bytes", ErrMalformed, len(txData))
+	}
+	if lifiExtractSelector(txData) != lifiSwapTokensGeneric {
+		return common.Address{}, nil, fmt.Errorf("%w: not a generic swap", ErrInvalidAction)
+	}
+	tx, err := decodeLiFiGeneric(txData)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return tx.Token, tx.Amount, nil
+}
+
+// lifiExtractSelector mirrors LiFiTxDataExtractor._extractSelector, see LiFiTx.Selector.
+func lifiExtractSelector(data []byte) [4]byte {
+	var sel [4]byte
+	copy(sel[:], data)
+	if sel == lifiStandardizedCall {
+		copy(sel[:], data[4:])
+		if len(data) < 8 {
+			sel = [4]byte{}
+		}
+	}
+	return sel
+}
+
+// lifiUnwrap returns the call a standardizedCall wraps, or data itself.
+func lifiUnwrap(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, lifiStandardizedCall[:]) {
+		return data, nil
+	}
+	out, err := lifiBytesArgs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: standardizedCall: %v", ErrMalformed, err)
+	}
+	inner := out[0].([]byte)
+	if len(inner) < 4 {
+		return nil, fmt.Errorf("%w: standardizedCall of %d bytes", ErrMalformed, len(inner))
+	}
+	return inner, nil
+}
+
+// decodeLiFiGeneric mirrors LiFiValidator.extractGenericSwapParameters.
+func decodeLiFiGeneric(data []byte) (LiFiTx, error) {
+	call, err := lifiUnwrap(data)
+	if err != nil {
+		return LiFiTx{}, err
+	}
+	out, err := lifiGenericArgs.Unpack(call[4:])
+	if err != nil {
+		return LiFiTx{}, fmt.Errorf("%w: swapTokensGeneric: %v", ErrMalformed, err)
+	}
+	swaps := *abi.ConvertType(out[5], new([]swapData)).(*[]swapData)
+	if len(swaps) == 0 {
+		return LiFiTx{}, fmt.Errorf("%w: swapTokensGeneric without swaps", ErrMalformed)
+	}
+	return LiFiTx{
+		Selector:    lifiSwapTokensGeneric,
+		Generic:     true,
+		Token:       native(swaps[0].SendingAssetId),
+		Amount:      swaps[0].FromAmount,
+		MinAmount:   out[4].(*big.Int),
+		Receiver:    out[3].(common.Address),
+		OutputToken: swaps[len(swaps)-1].ReceivingAssetId,
+	}, nil
+}
+
+// decodeLiFiBridge mirrors LiFiValidator.extractMainParameters.
+func decodeLiFiBridge(data []byte) (LiFiTx, error) {
+	bd, receiver, err := lifiExtractBridgeData(data)
+	if err != nil {
+		return LiFiTx{}, err
+	}
+	tx := LiFiTx{
+		Selector:           lifiExtractSelector(data),
+		Bridge:             bd.Bridge,
+		Token:              native(bd.SendingAssetId),
+		Amount:             bd.MinAmount,
+		MinAmount:          bd.MinAmount,
+		Receiver:           receiver,
+		DstChainID:         bd.DestinationChainId,
+		HasSourceSwaps:     bd.HasSourceSwaps,
+		HasDestinationCall: bd.HasDestinationCall,
+	}
+	if bd.HasSourceSwaps {
+		swaps, err := lifiExtractSwapData(data)
+		if err != nil {
+			return LiFiTx{}, err
+		}
+		tx.Token, tx.Amount = native(swaps[0].SendingAssetId), swaps[0].FromAmount
+	}
+	return tx, nil
+}
+
+// lifiExtractBridgeData mirrors LiFiTxDataExtractor._extractBridgeData. The Amarok and Stargate facets take the
+// receiver from their own data, every other facet from BridgeData.receiver.
+func lifiExtractBridgeData(data []byte) (bridgeData, common.Address, error) {
+	call, err := lifiUnwrap(data)
+	if err != nil {
+		return bridgeData{}, common.Address{}, err
+	}
+	var sel [4]byte
+	copy(sel[:], call)
+
+	var (
+		args  abi.Arguments
+		extra int
+	)
+	switch sel {
+	case lifiAmarok:
+		args, extra = abi.Arguments{{Type: bridgeDataType}, {Type: amarokDataType}}, 1
+	case lifiSwapAmarok:
+		args, extra = abi.Arguments{{Type: bridgeDataType}, {Type: swapDataType}, {Type: amarokDataType}}, 2
+	case lifiStargateBridge:
+		args, extra = abi.Arguments{{Type: bridgeDataType}, {Type: stargateDataType}}, 1
+	case lifiSwapStargate:
+		args, extra = abi.Arguments{{Type: bridgeDataType}, {Type: swapDataType}, {Type: stargateDataType}}, 2
+	case lifiCelerIMBridge:
+		args, extra = abi.Arguments{{Type: bridgeDataType}, {Type: celerIMDataType}}, 1
+	case lifiSwapCelerIMBridge:
+		args, extra = abi.Arguments{{Type: bridgeDataType}, {Type: swapDataType}, {Type: celerIMDataType}}, 2
+	default:
+		// Like the extractor, other facets are decoded from the outer calldata, which only works unwrapped.
+		args, call = lifiBridgeArgs, data
+	}
+	out, err := args.Unpack(call[4:])
+	if err != nil {
+		return bridgeData{}, common.Address{}, fmt.Errorf("%w: bridge data: %v", ErrMalformed, err)
+	}
+	bd := *abi.ConvertType(out[0], new(bridgeData)).(*bridgeData)
+
+	receiver := bd.Receiver
+	switch sel {
+	case lifiAmarok, lifiSwapAmarok:
+		receiver = abi.ConvertType(out[extra], new(amarokData)).(*amarokData).CallTo
+	case lifiStargateBridge, lifiSwapStargate:
+		// The extractor loads the first 20 bytes of callTo.
+		receiver = castToAddress(abi.ConvertType(out[extra], new(stargateData)).(*stargateData).CallTo)
+	}
+	return bd, receiver, nil
+}
+
+// lifiExtractSwapData mirrors LiFiTxDataExtractor._extractSwapData.
+func lifiExtractSwapData(data []byte) ([]swapData, error) {
+	call, err := lifiUnwrap(data)
+	if err != nil {
+		return nil, err
+	}
+	out, err := lifiSwapBridgeArgs.Unpack(call[4:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: swap data: %v", ErrMalformed, err)
+	}
+	swaps := *abi.ConvertType(out[1], new([]swapData)).(*[]swapData)
+	if len(swaps) == 0 {
+		return nil, fmt.Errorf("%w: source swaps without swap data", ErrMalformed)
+	}
+	return swaps, nil
+}
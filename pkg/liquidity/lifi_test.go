@@ -0,0 +1,279 @@
+package liquidity
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// celerIMData mirrors CelerIMFacetBase.CelerIMData, which the decoder skips.
+type celerIMData struct {
+	MaxSlippage   uint32
+	Nonce         uint64
+	CallTo        []byte
+	CallData      []byte
+	MessageBusFee *big.Int
+	BridgeType    uint8
+}
+
+var (
+	lifiAcross     = lifiSelector("startBridgeTokensViaAcross", lifiBridgeData, "(int64,uint32,bytes,uint256)")
+	lifiSwapAcross = lifiSelector("swapAndStartBridgeTokensViaAcross", lifiBridgeData, lifiSwapData, "(int64,uint32,bytes,uint256)")
+	lifiHop        = lifiSelector("startBridgeTokensViaHop", lifiBridgeData, lifiHopData)
+)
+
+func testBridgeData(token, receiver common.Address, dstChainID int64, sourceSwaps bool) bridgeData {
+	return bridgeData{
+		Bridge:             "across",
+		Integrator:         "superform",
+		SendingAssetId:     token,
+		Receiver:           receiver,
+		MinAmount:          big.NewInt(1000),
+		DestinationChainId: big.NewInt(dstChainID),
+		HasSourceSwaps:     sourceSwaps,
+	}
+}
+
+func testSwaps(from, to common.Address) []swapData {
+	return []swapData{
+		{SendingAssetId: from, ReceivingAssetId: testInterim, FromAmount: big.NewInt(1200), CallData: []byte{}},
+		{SendingAssetId: testInterim, ReceivingAssetId: to, FromAmount: big.NewInt(1100), CallData: []byte{}},
+	}
+}
+
+func lifiGeneric(t *testing.T, receiver common.Address, swaps []swapData) []byte {
+	return pack(t, lifiSwapTokensGeneric, lifiGenericArgs, [32]byte{}, "superform", "", receiver, big.NewInt(900), swaps)
+}
+
+func testStargateData(callTo []byte) stargateData {
+	return stargateData{
+		SrcPoolId: big.NewInt(1), DstPoolId: big.NewInt(1), MinAmountLD: big.NewInt(990), DstGasForCall: new(big.Int),
+		LzFee: big.NewInt(1), CallTo: callTo, CallData: []byte{},
+	}
+}
+
+func testCelerIMData() celerIMData {
+	return celerIMData{CallTo: []byte{}, CallData: []byte{}, MessageBusFee: new(big.Int)}
+}
+
+func testAmarokData(callTo common.Address) amarokData {
+	return amarokData{CallData: []byte{}, CallTo: callTo, RelayerFee: new(big.Int), SlippageTol: new(big.Int)}
+}
+
+func TestLiFiDecode(t *testing.T) {
+	bd := testBridgeData(testToken, testRegistry, 8453, false)
+	swapBD := testBridgeData(testInterim, testRegistry, 8453, true)
+	stargateArgs := abi.Arguments{{Type: bridgeDataType}, {Type: stargateDataType}}
+	stargateCall := pack(t, lifiStargateBridge, stargateArgs, testBridgeData(testToken, testUser, 8453, false), testStargateData(testRegistry.Bytes()))
+
+	tests := []struct {
+		name   string
+		lifi   func() *LiFi
+		txData []byte
+		want   DecodedLiquidity
+	}{
+		{
+			name:   "swapTokensGeneric",
+			txData: lifiGeneric(t, testSuperform, testSwaps(common.Address{}, testToken)),
+			want:   DecodedLiquidity{InputToken: Native, OutputToken: testToken, Amount: big.NewInt(1200), Receiver: testSuperform},
+		},
+		{
+			name:   "bridge",
+			txData: pack(t, lifiAcross, lifiBridgeArgs, bd),
+			want:   DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name:   "bridge native",
+			txData: pack(t, lifiAcross, lifiBridgeArgs, testBridgeData(common.Address{}, testRegistry, 8453, false)),
+			want:   DecodedLiquidity{InputToken: Native, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name:   "swap and bridge",
+			txData: pack(t, lifiSwapAcross, lifiSwapBridgeArgs, swapBD, testSwaps(testToken, testInterim)),
+			want:   DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1200), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name:   "stargate receiver from callTo",
+			txData: stargateCall,
+			want:   DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name:   "stargate short callTo",
+			txData: pack(t, lifiStargateBridge, stargateArgs, bd, testStargateData([]byte{0x22, 0x22})),
+			want: DecodedLiquidity{
+				InputToken: testToken, Amount: big.NewInt(1000), DstChainID: big.NewInt(8453),
+				Receiver: common.HexToAddress("0x2222000000000000000000000000000000000000"),
+			},
+		},
+		{
+			name: "swap and stargate",
+			txData: pack(t, lifiSwapStargate, abi.Arguments{{Type: bridgeDataType}, {Type: swapDataType}, {Type: stargateDataType}},
+				testBridgeData(testInterim, testUser, 8453, true), testSwaps(testToken, testInterim), testStargateData(testSwapper.Bytes())),
+			want: DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1200), Receiver: testSwapper, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name:   "celerIM",
+			txData: pack(t, lifiCelerIMBridge, abi.Arguments{{Type: bridgeDataType}, {Type: celerIMDataType}}, bd, testCelerIMData()),
+			want:   DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name: "swap and celerIM",
+			txData: pack(t, lifiSwapCelerIMBridge, abi.Arguments{{Type: bridgeDataType}, {Type: swapDataType}, {Type: celerIMDataType}},
+				swapBD, testSwaps(common.Address{}, testInterim), testCelerIMData()),
+			want: DecodedLiquidity{InputToken: Native, Amount: big.NewInt(1200), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name: "amarok once unblacklisted",
+			lifi: func() *LiFi {
+				l := NewLiFi()
+				l.Unblacklist(lifiAmarok)
+				return l
+			},
+			txData: pack(t, lifiAmarok, abi.Arguments{{Type: bridgeDataType}, {Type: amarokDataType}},
+				testBridgeData(testToken, testUser, 8453, false), testAmarokData(testRegistry)),
+			want: DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name:   "standardizedCall",
+			txData: pack(t, lifiStandardizedCall, lifiBytesArgs, stargateCall),
+			want:   DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLiFi()
+			if tt.lifi != nil {
+				l = tt.lifi()
+			}
+			got, err := l.Liquidity(tt.txData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkLiquidity(t, got, tt.want)
+		})
+	}
+}
+
+func TestLiFiDecodeSelector(t *testing.T) {
+	stargateCall := pack(t, lifiStargateBridge, abi.Arguments{{Type: bridgeDataType}, {Type: stargateDataType}},
+		testBridgeData(testToken, testUser, 8453, false), testStargateData(testRegistry.Bytes()))
+
+	tx, err := NewLiFi().Decode(stargateCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Selector != lifiStargateBridge || tx.Bridge != "across" || tx.Generic {
+		t.Fatalf("got %+v", tx)
+	}
+	// A wrapped call is dispatched on the zero high bytes of the bytes offset, never a listed selector.
+	if tx, err = NewLiFi().Decode(pack(t, lifiStandardizedCall, lifiBytesArgs, stargateCall)); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Selector != [4]byte{} {
+		t.Fatalf("wrapped selector %x", tx.Selector)
+	}
+}
+
+func TestLiFiValidate(t *testing.T) {
+	bridge := func(bd bridgeData) []byte { return pack(t, lifiAcross, lifiBridgeArgs, bd) }
+	withDstCall := testBridgeData(testToken, testUser, 1, false)
+	withDstCall.HasDestinationCall = true
+	sameChain := depositArgs(nil)
+	sameChain.DstChainID, sameChain.LiqDstChainID = 10, 10
+
+	tests := []struct {
+		name        string
+		args        *Args
+		txData      []byte
+		wantErr     error
+		wantDstSwap bool
+	}{
+		{name: "too short", txData: []byte{0x01, 0x02}, wantErr: ErrMalformed},
+		{name: "blacklisted before decoding", txData: append(lifiHop[:], 0xff), wantErr: ErrBlacklistedSelector},
+		{name: "standardizedCall too short", txData: pack(t, lifiStandardizedCall, lifiBytesArgs, []byte{0x01}), wantErr: ErrMalformed},
+		{name: "truncated bridge data", txData: append(lifiAcross[:], make([]byte, 32)...), wantErr: ErrMalformed},
+		// each case breaks every later rule too, so the first failing rule is the one reported
+		{name: "destination call first", txData: bridge(withDstCall), wantErr: ErrDestinationCall},
+		{name: "chain id before receiver", txData: bridge(testBridgeData(testInterim, testUser, 1, false)), wantErr: ErrChainID},
+		{name: "receiver before token", txData: bridge(testBridgeData(testInterim, testUser, 8453, false)), wantErr: ErrReceiver},
+		{name: "token", txData: bridge(testBridgeData(testInterim, testRegistry, 8453, false)), wantErr: ErrToken},
+		{name: "dst swap needs interim token", txData: bridge(testBridgeData(testToken, testSwapper, 8453, false)), wantErr: ErrInterimToken},
+		{
+			name:    "bridge for same chain deposit",
+			args:    &sameChain,
+			txData:  bridge(testBridgeData(testToken, testRegistry, 10, false)),
+			wantErr: ErrInvalidAction,
+		},
+		{name: "deposit to registry", txData: bridge(testBridgeData(testToken, testRegistry, 8453, false))},
+		{
+			name: "deposit to dst swapper",
+			args: func() *Args {
+				a := depositArgs(nil)
+				a.InterimToken = testInterim
+				return &a
+			}(),
+			txData:      bridge(testBridgeData(testToken, testSwapper, 8453, false)),
+			wantDstSwap: true,
+		},
+		{
+			name: "withdrawal to receiver",
+			args: func() *Args {
+				a := depositArgs(nil)
+				a.Deposit = false
+				return &a
+			}(),
+			txData: bridge(testBridgeData(testToken, testUser, 8453, false)),
+		},
+		{
+			name:    "generic swap across chains",
+			txData:  lifiGeneric(t, testSuperform, testSwaps(testToken, testInterim)),
+			wantErr: ErrChainID,
+		},
+		{
+			name:    "generic swap receiver",
+			args:    &sameChain,
+			txData:  lifiGeneric(t, testUser, testSwaps(testToken, testInterim)),
+			wantErr: ErrReceiver,
+		},
+		{
+			name:    "generic swap token",
+			args:    &sameChain,
+			txData:  lifiGeneric(t, testSuperform, testSwaps(testInterim, testToken)),
+			wantErr: ErrToken,
+		},
+		{name: "generic swap deposit", args: &sameChain, txData: lifiGeneric(t, testSuperform, testSwaps(testToken, testInterim))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := depositArgs(tt.txData)
+			if tt.args != nil {
+				args = *tt.args
+				args.TxData = tt.txData
+			}
+			hasDstSwap, err := NewLiFi().Validate(args)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			if hasDstSwap != tt.wantDstSwap {
+				t.Fatalf("hasDstSwap %v, want %v", hasDstSwap, tt.wantDstSwap)
+			}
+		})
+	}
+}
+
+func TestLiFiDstSwap(t *testing.T) {
+	l := NewLiFi()
+	token, amount, err := l.DstSwap(lifiGeneric(t, testRegistry, testSwaps(testInterim, testToken)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != testInterim || amount.Cmp(big.NewInt(1200)) != 0 {
+		t.Fatalf("got %s %s", token, amount)
+	}
+	if _, _, err := l.DstSwap(pack(t, lifiAcross, lifiBridgeArgs, testBridgeData(testToken, testRegistry, 8453, false))); !errors.Is(err, ErrInvalidAction) {
+		t.Fatalf("bridge accepted as dst swap: %v", err)
+	}
+}
@@ -0,0 +1,237 @@
+// Package liquidity decodes and validates LiqRequest txData offline.
+//
+// LiqRequest.TxData is calldata for an external bridge or swap contract. It is checked on chain by the bridge
+// validator that SuperRegistry registers for the request's BridgeId. The decoders here parse the calldata shapes
+// each validator accepts and apply the same rules, so a quote the router, a form or a state registry would revert
+// on can be rejected before it is submitted.
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Native is the address BridgeValidator uses for the chain's native token.
+var Native = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// Bridge ids of the deployment scripts, see registry.BridgeValidators.
+const (
+	BridgeSocket            uint8 = 2
+	BridgeSocketOneInch     uint8 = 3
+	BridgeOneInch           uint8 = 4
+	BridgeDeBridge          uint8 = 5
+	BridgeDeBridgeForwarder uint8 = 6
+	BridgeLiFi              uint8 = 101
+)
+
+// Validation errors. Each mirrors the Error library revert named in its comment.
+var (
+	// ErrMalformed is returned for txData the validator cannot abi decode, which reverts on chain.
+	ErrMalformed = errors.New("liquidity: malformed txData")
+	// ErrBlacklistedSelector mirrors BLACKLISTED_SELECTOR.
+	ErrBlacklistedSelector = errors.New("liquidity: selector is blacklisted")
+	// ErrBlacklistedRouteID mirrors BLACKLISTED_ROUTE_ID.
+	ErrBlacklistedRouteID = errors.New("liquidity: route id is blacklisted")
+	// ErrDestinationCall mirrors INVALID_TXDATA_NO_DESTINATIONCALL_ALLOWED.
+	ErrDestinationCall = errors.New("liquidity: destination call not allowed")
+	// ErrChainID mirrors INVALID_TXDATA_CHAIN_ID.
+	ErrChainID = errors.New("liquidity: invalid txData chain id")
+	// ErrDepositLiqDstChainID mirrors INVALID_DEPOSIT_LIQ_DST_CHAIN_ID.
+	ErrDepositLiqDstChainID = errors.New("liquidity: invalid deposit liqDstChainId")
+	// ErrReceiver mirrors INVALID_TXDATA_RECEIVER.
+	ErrReceiver = errors.New("liquidity: invalid txData receiver")
+	// ErrToken mirrors INVALID_TXDATA_TOKEN.
+	ErrToken = errors.New("liquidity: invalid txData token")
+	// ErrInterimToken mirrors INVALID_INTERIM_TOKEN.
+	ErrInterimToken = errors.New("liquidity: missing interim token")
+	// ErrInvalidAction mirrors INVALID_ACTION.
+	ErrInvalidAction = errors.New("liquidity: invalid action")
+	// ErrUnknownBridge is returned for a bridge id without a decoder.
+	ErrUnknownBridge = errors.New("liquidity: unknown bridge id")
+)
+
+// DecodedLiquidity is txData normalized across bridges and swaps.
+type DecodedLiquidity struct {
+	// InputToken is the token txData spends.
+	InputToken common.Address
+	// OutputToken is the token delivered to Receiver, zero when the calldata does not name it, as for bridges.
+	OutputToken common.Address
+	// Amount is the amount of InputToken spent.
+	Amount   *big.Int
+	Receiver common.Address
+	// DstChainID is the chain a bridge delivers to, nil for a same chain swap.
+	DstChainID *big.Int
+}
+
+// Args mirrors IBridgeValidator.ValidateTxDataArgs, together with the SuperRegistry addresses the validators read.
+type Args struct {
+	TxData        []byte
+	SrcChainID    uint64
+	DstChainID    uint64
+	LiqDstChainID uint64
+	Deposit       bool
+	Superform     common.Address
+	// ReceiverAddress is who a withdrawal must pay out to.
+	ReceiverAddress common.Address
+	// Token is the token txData must spend.
+	Token        common.Address
+	InterimToken common.Address
+
+	// CoreStateRegistry and DstSwapper are the registry addresses on DstChainID, the receivers a cross-chain
+	// deposit may bridge to.
+	CoreStateRegistry common.Address
+	DstSwapper        common.Address
+	// Rescuer is the CORE_STATE_REGISTRY_RESCUER_ROLE address on LiqDstChainID, the order authority deBridge
+	// orders must name.
+	Rescuer common.Address
+}
+
+// RouterArgs returns the Args BaseRouterImplementation validates req with when srcSender deposits into or
+// withdraws from superform on dstChainID. The registry addresses are left to the caller.
+func RouterArgs(req contracts.LiqRequest, srcChainID, dstChainID uint64, deposit bool, superform, srcSender common.Address) Args {
+	return Args{
+		TxData:          req.TxData,
+		SrcChainID:      srcChainID,
+		DstChainID:      dstChainID,
+		LiqDstChainID:   req.LiqDstChainId,
+		Deposit:         deposit,
+		Superform:       superform,
+		ReceiverAddress: srcSender,
+		Token:           req.Token,
+		InterimToken:    req.InterimToken,
+	}
+}
+
+// WithdrawArgs returns the Args a form validates data.LiqData with when it withdraws from superform on chainID for
+// a request from srcChainID, which is chainID for a same chain withdrawal. txData must spend the vault asset and pay
+// out to data.ReceiverAddress. The registry addresses are left to the caller.
+func WithdrawArgs(data contracts.InitSingleVaultData, chainID, srcChainID uint64, superform, vaultAsset common.Address) Args {
+	return Args{
+		TxData:          data.LiqData.TxData,
+		SrcChainID:      chainID,
+		DstChainID:      srcChainID,
+		LiqDstChainID:   data.LiqData.LiqDstChainId,
+		Superform:       superform,
+		ReceiverAddress: data.ReceiverAddress,
+		Token:           vaultAsset,
+	}
+}
+
+// Decoder decodes and validates the txData of one bridge id.
+type Decoder interface {
+	// Liquidity decodes txData.
+	Liquidity(txData []byte) (DecodedLiquidity, error)
+	// Validate checks args.TxData like the validator's validateTxData and returns whether a deposit has a dst
+	// swap.
+	Validate(args Args) (bool, error)
+}
+
+// Decoders returns a decoder for every bridge id, with the validators' initial blacklists.
+func Decoders() map[uint8]Decoder {
+	return map[uint8]Decoder{
+		BridgeSocket:            NewSocket(),
+		BridgeSocketOneInch:     SocketOneInch{},
+		BridgeOneInch:           OneInch{},
+		BridgeDeBridge:          DeBridge{},
+		BridgeDeBridgeForwarder: DeBridgeForwarder{},
+		BridgeLiFi:              NewLiFi(),
+	}
+}
+
+// Validate validates req with the decoder of its bridge id. args is completed from req like RouterArgs does.
+func Validate(decoders map[uint8]Decoder, req contracts.LiqRequest, args Args) (bool, error) {
+	d, ok := decoders[req.BridgeId]
+	if !ok {
+		return false, fmt.Errorf("%w: %d", ErrUnknownBridge, req.BridgeId)
+	}
+	args.TxData, args.LiqDstChainID, args.Token, args.InterimToken = req.TxData, req.LiqDstChainId, req.Token, req.InterimToken
+	return d.Validate(args)
+}
+
+// validateSwap applies the rules of same chain swaps: a deposit must swap into args.Superform on the chain it is
+// made on, a withdrawal must pay out to args.ReceiverAddress.
+func validateSwap(args Args, receiver common.Address) error {
+	if args.Deposit {
+		if args.SrcChainID != args.DstChainID {
+			return fmt.Errorf("%w: swap from %d to %d", ErrChainID, args.SrcChainID, args.DstChainID)
+		}
+		if args.DstChainID != args.LiqDstChainID {
+			return fmt.Errorf("%w: %d on chain %d", ErrDepositLiqDstChainID, args.LiqDstChainID, args.DstChainID)
+		}
+		if receiver != args.Superform {
+			return fmt.Errorf("%w: %s, want superform %s", ErrReceiver, receiver, args.Superform)
+		}
+	} else if receiver != args.ReceiverAddress {
+		return fmt.Errorf("%w: %s, want %s", ErrReceiver, receiver, args.ReceiverAddress)
+	}
+	return nil
+}
+
+// validateBridge applies the rules of bridges: txData must go to args.LiqDstChainID, and be either a cross-chain
+// deposit to the CoreStateRegistry or the DstSwapper, the latter requiring an interim token, or a withdrawal to
+// args.ReceiverAddress. It returns whether a deposit goes to the DstSwapper.
+func validateBridge(args Args, receiver common.Address, dstChainID *big.Int) (bool, error) {
+	if dstChainID.Cmp(new(big.Int).SetUint64(args.LiqDstChainID)) != 0 {
+		return false, fmt.Errorf("%w: %s, want %d", ErrChainID, dstChainID, args.LiqDstChainID)
+	}
+	if !args.Deposit {
+		if receiver != args.ReceiverAddress {
+			return false, fmt.Errorf("%w: %s, want %s", ErrReceiver, receiver, args.ReceiverAddress)
+		}
+		return false, nil
+	}
+	if args.SrcChainID == args.DstChainID {
+		return false, fmt.Errorf("%w: bridge for a same chain deposit", ErrInvalidAction)
+	}
+	hasDstSwap := receiver == args.DstSwapper
+	if receiver != args.CoreStateRegistry && !hasDstSwap {
+		return false, fmt.Errorf("%w: %s is neither CoreStateRegistry nor DstSwapper on %d", ErrReceiver, receiver, args.DstChainID)
+	}
+	if hasDstSwap && args.InterimToken == (common.Address{}) {
+		return false, ErrInterimToken
+	}
+	return hasDstSwap, nil
+}
+
+func checkToken(token, want common.Address) error {
+	if token != want {
+		return fmt.Errorf("%w: %s, want %s", ErrToken, token, want)
+	}
+	return nil
+}
+
+// castToAddress mirrors the validators' address(uint160(bytes20(b))): the first 20 bytes of b, zero padded.
+func castToAddress(b []byte) common.Address {
+	var a common.Address
+	copy(a[:], b)
+	return a
+}
+
+// native maps the zero address bridges use for the native token to Native.
+func native(token common.Address) common.Address {
+	if token == (common.Address{}) {
+		return Native
+	}
+	return token
+}
+
+func selector(signature string) [4]byte {
+	var s [4]byte
+	copy(s[:], crypto.Keccak256([]byte(signature)))
+	return s
+}
+
+func mustType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
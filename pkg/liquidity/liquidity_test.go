@@ -0,0 +1,97 @@
+package liquidity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	testToken     = common.HexToAddress("0x0b2C639c533813f4Aa9D7837CAf62653d097Ff85")
+	testInterim   = common.HexToAddress("0xDA10009cBd5D07dd0CeCc66161FC93D7c9000da1")
+	testSuperform = common.HexToAddress("0x5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f")
+	testUser      = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testRegistry  = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testSwapper   = common.HexToAddress("0x3333333333333333333333333333333333333333")
+	testRouter    = common.HexToAddress("0x4444444444444444444444444444444444444444")
+)
+
+// pack returns sel followed by the abi encoding of values.
+func pack(t *testing.T, sel [4]byte, args abi.Arguments, values ...interface{}) []byte {
+	t.Helper()
+	encoded, err := args.Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(sel[:], encoded...)
+}
+
+// depositArgs are the Args of a cross-chain deposit from chain 10 to chain 8453.
+func depositArgs(txData []byte) Args {
+	return Args{
+		TxData:            txData,
+		SrcChainID:        10,
+		DstChainID:        8453,
+		LiqDstChainID:     8453,
+		Deposit:           true,
+		Superform:         testSuperform,
+		ReceiverAddress:   testUser,
+		Token:             testToken,
+		CoreStateRegistry: testRegistry,
+		DstSwapper:        testSwapper,
+	}
+}
+
+func checkLiquidity(t *testing.T, got, want DecodedLiquidity) {
+	t.Helper()
+	if got.InputToken != want.InputToken || got.OutputToken != want.OutputToken || got.Receiver != want.Receiver {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if got.Amount == nil || got.Amount.Cmp(want.Amount) != 0 {
+		t.Fatalf("amount %v, want %v", got.Amount, want.Amount)
+	}
+	if (got.DstChainID == nil) != (want.DstChainID == nil) || (got.DstChainID != nil && got.DstChainID.Cmp(want.DstChainID) != 0) {
+		t.Fatalf("dst chain %v, want %v", got.DstChainID, want.DstChainID)
+	}
+}
+
+func TestCastToAddress(t *testing.T) {
+	full := common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	tests := []struct {
+		name string
+		in   []byte
+		want common.Address
+	}{
+		{"empty", nil, common.Address{}},
+		{"short", []byte{0x01, 0x02}, common.BytesToAddress(append([]byte{0x01, 0x02}, make([]byte, 18)...))},
+		{"exact", full[:], full},
+		{"long", append(full.Bytes(), 0xff, 0xff), full},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := castToAddress(tt.in); got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNative(t *testing.T) {
+	if got := native(common.Address{}); got != Native {
+		t.Fatalf("zero address mapped to %s", got)
+	}
+	if got := native(testToken); got != testToken {
+		t.Fatalf("token mapped to %s", got)
+	}
+}
+
+func TestValidateUnknownBridge(t *testing.T) {
+	_, err := Validate(Decoders(), contracts.LiqRequest{BridgeId: 200}, depositArgs(nil))
+	if !errors.Is(err, ErrUnknownBridge) {
+		t.Fatalf("err %v, want %v", err, ErrUnknownBridge)
+	}
+}
@@ -0,0 +1,261 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OneInchValidator errors.
+var (
+	// ErrTokenPair mirrors INVALID_TOKEN_PAIR, an unoswapTo through a pair without the input token.
+	ErrTokenPair = errors.New("liquidity: input token not in pair")
+	// ErrPermit2Data mirrors INVALID_PERMIT2_DATA.
+	ErrPermit2Data = errors.New("liquidity: permit2 swaps not allowed")
+	// ErrPartialFill mirrors PARTIAL_FILL_NOT_ALLOWED.
+	ErrPartialFill = errors.New("liquidity: partial fill not allowed")
+)
+
+// dexPoolABI is the slice of the Uniswap pair and Curve pool interfaces OneInchValidator reads.
+const dexPoolABI = `[{"type":"function","name":"token0","inputs":[],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"},{"type":"function","name":"token1","inputs":[],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"},{"type":"function","name":"underlying_coins","inputs":[{"name":"index","type":"int128","internalType":"int128"}],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"}]`
+
+var (
+	oneInchUnoswapTo = selector("unoswapTo(uint256,uint256,uint256,uint256,uint256)")
+	oneInchSwap      = selector("swap(address,(address,address,address,address,uint256,uint256,uint256),bytes)")
+
+	oneInchUnoswapArgs = abi.Arguments{
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("uint256", nil)},
+	}
+	oneInchSwapArgs = abi.Arguments{
+		{Type: mustType("address", nil)},
+		{Type: mustType("tuple", []abi.ArgumentMarshaling{
+			{Name: "srcToken", Type: "address"},
+			{Name: "dstToken", Type: "address"},
+			{Name: "srcReceiver", Type: "address"},
+			{Name: "dstReceiver", Type: "address"},
+			{Name: "amount", Type: "uint256"},
+			{Name: "minReturnAmount", Type: "uint256"},
+			{Name: "flags", Type: "uint256"},
+		})},
+		{Type: mustType("bytes", nil)},
+	}
+)
+
+// Flags of the AggregationRouterV6 swap description and of the packed unoswap dex word.
+const (
+	oneInchPartialFill = 0
+	oneInchUsePermit2  = 2
+
+	dexProtocolOffset   = 253
+	dexUnwrapWeth       = 252
+	dexUsePermit2       = 250
+	dexCurveToOffset    = 216
+	dexCurveToIndexMask = 0xff
+)
+
+// OneInchProtocol mirrors ProtocolLib.Protocol, the pool kind of an unoswapTo.
+type OneInchProtocol uint8
+
+const (
+	UniswapV2 OneInchProtocol = iota
+	UniswapV3
+	Curve
+)
+
+func (p OneInchProtocol) String() string {
+	switch p {
+	case UniswapV2:
+		return "UniswapV2"
+	case UniswapV3:
+		return "UniswapV3"
+	case Curve:
+		return "Curve"
+	default:
+		return fmt.Sprintf("OneInchProtocol(%d)", uint8(p))
+	}
+}
+
+// swapDescription mirrors IAggregationRouterV6.SwapDescription.
+type swapDescription struct {
+	SrcToken        common.Address
+	DstToken        common.Address
+	SrcReceiver     common.Address
+	DstReceiver     common.Address
+	Amount          *big.Int
+	MinReturnAmount *big.Int
+	Flags           *big.Int
+}
+
+// OneInchTx is AggregationRouterV6 calldata as OneInchValidator reads it.
+type OneInchTx struct {
+	// Unoswap is set for unoswapTo, unset for swap.
+	Unoswap  bool
+	Token    common.Address
+	Amount   *big.Int
+	Receiver common.Address
+	// OutputToken is the swap description's dstToken. For unoswapTo it is Native when the pool output is
+	// unwrapped and zero otherwise: the validator reads it from the pool, see ResolveOutputToken.
+	OutputToken common.Address
+
+	// Pool, Protocol, CurveToIndex and UnwrapWeth are unpacked from the unoswapTo dex word.
+	Pool         common.Address
+	Protocol     OneInchProtocol
+	CurveToIndex uint8
+	UnwrapWeth   bool
+}
+
+// Liquidity returns tx normalized.
+func (tx OneInchTx) Liquidity() DecodedLiquidity {
+	return DecodedLiquidity{
+		InputToken:  tx.Token,
+		OutputToken: tx.OutputToken,
+		Amount:      tx.Amount,
+		Receiver:    tx.Receiver,
+	}
+}
+
+// ResolveOutputToken returns the token an unoswapTo pays out like OneInchValidator: the Curve pool's underlying
+// coin at CurveToIndex, or the pair token that is not the input token, which must be in the pair. Unwrapped WETH
+// is Native. It returns OutputToken for a swap.
+func (tx OneInchTx) ResolveOutputToken(ctx context.Context, caller bind.ContractCaller) (common.Address, error) {
+	if !tx.Unoswap {
+		return tx.OutputToken, nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(dexPoolABI))
+	if err != nil {
+		return common.Address{}, err
+	}
+	pool := bind.NewBoundContract(tx.Pool, parsed, caller, nil, nil)
+	call := func(method string, args ...interface{}) (common.Address, error) {
+		var out []interface{}
+		if err := pool.Call(&bind.CallOpts{Context: ctx}, &out, method, args...); err != nil {
+			return common.Address{}, fmt.Errorf("liquidity: pool %s %s: %w", tx.Pool, method, err)
+		}
+		return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+	}
+
+	var out common.Address
+	if tx.Protocol == Curve {
+		if out, err = call("underlying_coins", big.NewInt(int64(tx.CurveToIndex))); err != nil {
+			return common.Address{}, err
+		}
+	} else {
+		token0, err := call("token0")
+		if err != nil {
+			return common.Address{}, err
+		}
+		token1, err := call("token1")
+		if err != nil {
+			return common.Address{}, err
+		}
+		switch tx.Token {
+		case token0:
+			out = token1
+		case token1:
+			out = token0
+		default:
+			return common.Address{}, fmt.Errorf("%w: %s in pool %s", ErrTokenPair, tx.Token, tx.Pool)
+		}
+	}
+	if tx.UnwrapWeth {
+		out = Native
+	}
+	return out, nil
+}
+
+// OneInch decodes and validates AggregationRouterV6 calldata the way OneInchValidator does. Only unoswapTo and
+// swap are accepted, neither with Permit2 and swap without partial fills. It is a same chain swap.
+//
+// Decoding is offline, so the pool reads of an unoswapTo are left to OneInchTx.ResolveOutputToken. On chain they
+// also revert an unoswapTo through a pair without the input token, which Validate cannot see.
+type OneInch struct{}
+
+// Decode decodes txData.
+func (OneInch) Decode(txData []byte) (OneInchTx, error) {
+	if len(txData) < 4 {
+		return OneInchTx{}, fmt.Errorf("%w: %d bytes", ErrMalformed, len(txData))
+	}
+	var sel [4]byte
+	copy(sel[:], txData)
+	switch sel {
+	case oneInchUnoswapTo:
+		out, err := oneInchUnoswapArgs.Unpack(txData[4:])
+		if err != nil {
+			return OneInchTx{}, fmt.Errorf("%w: unoswapTo: %v", ErrMalformed, err)
+		}
+		dex := out[4].(*big.Int)
+		if dex.Bit(dexUsePermit2) != 0 {
+			return OneInchTx{}, ErrPermit2Data
+		}
+		protocol := new(big.Int).Rsh(dex, dexProtocolOffset).Uint64()
+		if protocol > uint64(Curve) {
+			return OneInchTx{}, fmt.Errorf("%w: unoswapTo protocol %d", ErrMalformed, protocol)
+		}
+		tx := OneInchTx{
+			Unoswap:      true,
+			Token:        common.BigToAddress(out[1].(*big.Int)),
+			Amount:       out[2].(*big.Int),
+			Receiver:     common.BigToAddress(out[0].(*big.Int)),
+			Pool:         common.BigToAddress(dex),
+			Protocol:     OneInchProtocol(protocol),
+			CurveToIndex: uint8(new(big.Int).Rsh(dex, dexCurveToOffset).Uint64() & dexCurveToIndexMask),
+			UnwrapWeth:   dex.Bit(dexUnwrapWeth) != 0,
+		}
+		if tx.UnwrapWeth {
+			tx.OutputToken = Native
+		}
+		return tx, nil
+	case oneInchSwap:
+		out, err := oneInchSwapArgs.Unpack(txData[4:])
+		if err != nil {
+			return OneInchTx{}, fmt.Errorf("%w: swap: %v", ErrMalformed, err)
+		}
+		desc := *abi.ConvertType(out[1], new(swapDescription)).(*swapDescription)
+		if desc.Flags.Bit(oneInchUsePermit2) != 0 {
+			return OneInchTx{}, ErrPermit2Data
+		}
+		if desc.Flags.Bit(oneInchPartialFill) != 0 {
+			return OneInchTx{}, ErrPartialFill
+		}
+		return OneInchTx{
+			Token:       desc.SrcToken,
+			Amount:      desc.Amount,
+			Receiver:    desc.DstReceiver,
+			OutputToken: desc.DstToken,
+		}, nil
+	default:
+		return OneInchTx{}, fmt.Errorf("%w: %x", ErrBlacklistedSelector, sel)
+	}
+}
+
+// Liquidity decodes txData into a DecodedLiquidity.
+func (o OneInch) Liquidity(txData []byte) (DecodedLiquidity, error) {
+	tx, err := o.Decode(txData)
+	if err != nil {
+		return DecodedLiquidity{}, err
+	}
+	return tx.Liquidity(), nil
+}
+
+// Validate checks args.TxData like OneInchValidator.validateTxData: the swap rules apply and the swap must spend
+// args.Token.
+func (o OneInch) Validate(args Args) (bool, error) {
+	tx, err := o.Decode(args.TxData)
+	if err != nil {
+		return false, err
+	}
+	if err := validateSwap(args, tx.Receiver); err != nil {
+		return false, err
+	}
+	return false, checkToken(tx.Token, args.Token)
+}
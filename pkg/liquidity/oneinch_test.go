@@ -0,0 +1,192 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	testPool             = common.HexToAddress("0x5555555555555555555555555555555555555555")
+	oneInchClipperSwapTo = selector("clipperSwapTo(address,address,address,address,uint256,uint256,uint256,bytes32,bytes32)")
+)
+
+// dexWord packs an unoswapTo dex word for testPool.
+func dexWord(protocol OneInchProtocol, curveToIndex uint8, flags ...int) *big.Int {
+	dex := new(big.Int).SetBytes(testPool.Bytes())
+	dex.Or(dex, new(big.Int).Lsh(big.NewInt(int64(protocol)), dexProtocolOffset))
+	dex.Or(dex, new(big.Int).Lsh(big.NewInt(int64(curveToIndex)), dexCurveToOffset))
+	for _, bit := range flags {
+		dex.SetBit(dex, bit, 1)
+	}
+	return dex
+}
+
+func unoswapTo(t *testing.T, receiver, token common.Address, dex *big.Int) []byte {
+	return pack(t, oneInchUnoswapTo, oneInchUnoswapArgs,
+		new(big.Int).SetBytes(receiver.Bytes()), new(big.Int).SetBytes(token.Bytes()), big.NewInt(1000), big.NewInt(990), dex)
+}
+
+func oneInchSwapData(t *testing.T, token, receiver common.Address, flags ...int) []byte {
+	desc := swapDescription{
+		SrcToken:        token,
+		DstToken:        testInterim,
+		SrcReceiver:     testRouter,
+		DstReceiver:     receiver,
+		Amount:          big.NewInt(1000),
+		MinReturnAmount: big.NewInt(990),
+		Flags:           new(big.Int),
+	}
+	for _, bit := range flags {
+		desc.Flags.SetBit(desc.Flags, bit, 1)
+	}
+	return pack(t, oneInchSwap, oneInchSwapArgs, testRouter, desc, []byte{})
+}
+
+func TestOneInchDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		txData  []byte
+		want    OneInchTx
+		wantErr error
+	}{
+		{
+			name:   "unoswapTo uniswap v3",
+			txData: unoswapTo(t, testSuperform, testToken, dexWord(UniswapV3, 0)),
+			want:   OneInchTx{Unoswap: true, Token: testToken, Amount: big.NewInt(1000), Receiver: testSuperform, Pool: testPool, Protocol: UniswapV3},
+		},
+		{
+			name:   "unoswapTo curve",
+			txData: unoswapTo(t, testSuperform, testToken, dexWord(Curve, 3)),
+			want:   OneInchTx{Unoswap: true, Token: testToken, Amount: big.NewInt(1000), Receiver: testSuperform, Pool: testPool, Protocol: Curve, CurveToIndex: 3},
+		},
+		{
+			name:   "unoswapTo unwrap weth",
+			txData: unoswapTo(t, testSuperform, testToken, dexWord(UniswapV2, 0, dexUnwrapWeth)),
+			want:   OneInchTx{Unoswap: true, Token: testToken, Amount: big.NewInt(1000), Receiver: testSuperform, OutputToken: Native, Pool: testPool, UnwrapWeth: true},
+		},
+		{name: "unoswapTo permit2", txData: unoswapTo(t, testSuperform, testToken, dexWord(UniswapV2, 0, dexUsePermit2)), wantErr: ErrPermit2Data},
+		{name: "unoswapTo unknown protocol", txData: unoswapTo(t, testSuperform, testToken, dexWord(Curve+1, 0)), wantErr: ErrMalformed},
+		{
+			name:   "swap",
+			txData: oneInchSwapData(t, testToken, testSuperform),
+			want:   OneInchTx{Token: testToken, Amount: big.NewInt(1000), Receiver: testSuperform, OutputToken: testInterim},
+		},
+		{name: "swap permit2", txData: oneInchSwapData(t, testToken, testSuperform, oneInchUsePermit2), wantErr: ErrPermit2Data},
+		{name: "swap partial fill", txData: oneInchSwapData(t, testToken, testSuperform, oneInchPartialFill), wantErr: ErrPartialFill},
+		{name: "unknown selector", txData: append(oneInchClipperSwapTo[:], make([]byte, 32)...), wantErr: ErrBlacklistedSelector},
+		{name: "truncated", txData: append(oneInchUnoswapTo[:], make([]byte, 64)...), wantErr: ErrMalformed},
+		{name: "too short", txData: []byte{0x01}, wantErr: ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OneInch{}.Decode(tt.txData)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Amount.Cmp(tt.want.Amount) != 0 {
+				t.Fatalf("amount %v, want %v", got.Amount, tt.want.Amount)
+			}
+			got.Amount = tt.want.Amount
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// poolCaller answers the dexPoolABI reads of testPool.
+type poolCaller struct {
+	token0, token1, coin common.Address
+}
+
+func (poolCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (c poolCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	var sel [4]byte
+	copy(sel[:], call.Data)
+	switch sel {
+	case selector("token0()"):
+		return common.LeftPadBytes(c.token0.Bytes(), 32), nil
+	case selector("token1()"):
+		return common.LeftPadBytes(c.token1.Bytes(), 32), nil
+	default:
+		return common.LeftPadBytes(c.coin.Bytes(), 32), nil
+	}
+}
+
+func TestOneInchResolveOutputToken(t *testing.T) {
+	pool := poolCaller{token0: testInterim, token1: testToken, coin: testRouter}
+	tests := []struct {
+		name    string
+		dex     *big.Int
+		token   common.Address
+		want    common.Address
+		wantErr error
+	}{
+		{name: "token1 in", dex: dexWord(UniswapV2, 0), token: testToken, want: testInterim},
+		{name: "token0 in", dex: dexWord(UniswapV3, 0), token: testInterim, want: testToken},
+		{name: "unwrap weth", dex: dexWord(UniswapV2, 0, dexUnwrapWeth), token: testToken, want: Native},
+		{name: "curve", dex: dexWord(Curve, 1), token: testUser, want: testRouter},
+		{name: "token not in pair", dex: dexWord(UniswapV2, 0), token: testUser, wantErr: ErrTokenPair},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := OneInch{}.Decode(unoswapTo(t, testSuperform, tt.token, tt.dex))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := tx.ResolveOutputToken(context.Background(), pool)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOneInchValidate(t *testing.T) {
+	sameChain := depositArgs(nil)
+	sameChain.DstChainID, sameChain.LiqDstChainID = 10, 10
+
+	tests := []struct {
+		name    string
+		args    Args
+		txData  []byte
+		wantErr error
+	}{
+		{name: "decode before swap rules", args: depositArgs(nil), txData: oneInchSwapData(t, testInterim, testUser, oneInchPartialFill), wantErr: ErrPartialFill},
+		{name: "cross chain deposit", args: depositArgs(nil), txData: oneInchSwapData(t, testInterim, testUser), wantErr: ErrChainID},
+		{name: "receiver before token", args: sameChain, txData: oneInchSwapData(t, testInterim, testUser), wantErr: ErrReceiver},
+		{name: "token", args: sameChain, txData: unoswapTo(t, testSuperform, testInterim, dexWord(UniswapV2, 0)), wantErr: ErrToken},
+		{name: "swap", args: sameChain, txData: oneInchSwapData(t, testToken, testSuperform)},
+		{name: "unoswapTo", args: sameChain, txData: unoswapTo(t, testSuperform, testToken, dexWord(Curve, 2))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			args.TxData = tt.txData
+			hasDstSwap, err := OneInch{}.Validate(args)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			if hasDstSwap {
+				t.Fatal("same chain swap reported a dst swap")
+			}
+		})
+	}
+}
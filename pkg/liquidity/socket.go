@@ -0,0 +1,167 @@
+package liquidity
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	socketRequestComponents = []abi.ArgumentMarshaling{
+		{Name: "id", Type: "uint256"},
+		{Name: "optionalNativeAmount", Type: "uint256"},
+		{Name: "inputToken", Type: "address"},
+		{Name: "data", Type: "bytes"},
+	}
+	socketUserRequestArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "receiverAddress", Type: "address"},
+		{Name: "toChainId", Type: "uint256"},
+		{Name: "amount", Type: "uint256"},
+		{Name: "middlewareRequest", Type: "tuple", Components: socketRequestComponents},
+		{Name: "bridgeRequest", Type: "tuple", Components: socketRequestComponents},
+	})}}
+	socketSwapInputArgs = abi.Arguments{
+		{Type: mustType("address", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("address", nil)},
+		{Type: mustType("uint256", nil)},
+		{Type: mustType("bytes", nil)},
+	}
+)
+
+// SocketRequest mirrors ISocketRegistry.MiddlewareRequest and ISocketRegistry.BridgeRequest. A zero id means no
+// middleware swap.
+type SocketRequest struct {
+	Id                   *big.Int
+	OptionalNativeAmount *big.Int
+	InputToken           common.Address
+	Data                 []byte
+}
+
+// SocketTx mirrors ISocketRegistry.UserRequest, the outboundTransferTo argument.
+type SocketTx struct {
+	ReceiverAddress   common.Address
+	ToChainId         *big.Int
+	Amount            *big.Int
+	MiddlewareRequest SocketRequest
+	BridgeRequest     SocketRequest
+}
+
+// Token returns the token the request spends: the middleware swap's input token, or the bridged token without a
+// middleware swap.
+func (tx SocketTx) Token() common.Address {
+	if tx.MiddlewareRequest.Id.Sign() != 0 {
+		return tx.MiddlewareRequest.InputToken
+	}
+	return tx.BridgeRequest.InputToken
+}
+
+// Liquidity returns tx normalized. The token delivered on the destination chain is not part of the request.
+func (tx SocketTx) Liquidity() DecodedLiquidity {
+	return DecodedLiquidity{
+		InputToken: tx.Token(),
+		Amount:     tx.Amount,
+		Receiver:   tx.ReceiverAddress,
+		DstChainID: tx.ToChainId,
+	}
+}
+
+// Socket decodes and validates Socket registry calldata the way SocketValidator does.
+type Socket struct {
+	blacklist map[string]bool
+}
+
+// NewSocket creates a Socket without blacklisted routes, like a freshly deployed SocketValidator. Blacklist and
+// Unblacklist follow the emergency admin's addToBlacklist and removeFromBlacklist calls.
+func NewSocket() *Socket {
+	return &Socket{blacklist: make(map[string]bool)}
+}
+
+// Blacklist blacklists the bridge route id.
+func (s *Socket) Blacklist(routeID *big.Int) {
+	s.blacklist[routeID.String()] = true
+}
+
+// Unblacklist removes the bridge route id from the blacklist.
+func (s *Socket) Unblacklist(routeID *big.Int) {
+	delete(s.blacklist, routeID.String())
+}
+
+// Blacklisted reports whether the bridge route id is blacklisted.
+func (s *Socket) Blacklisted(routeID *big.Int) bool {
+	return s.blacklist[routeID.String()]
+}
+
+// Decode decodes txData. Like SocketValidator it does not check the selector.
+func (s *Socket) Decode(txData []byte) (SocketTx, error) {
+	if len(txData) < 4 {
+		return SocketTx{}, fmt.Errorf("%w: %d bytes", ErrMalformed, len(txData))
+	}
+	out, err := socketUserRequestArgs.Unpack(txData[4:])
+	if err != nil {
+		return SocketTx{}, fmt.Errorf("%w: user request: %v", ErrMalformed, err)
+	}
+	return *abi.ConvertType(out[0], new(SocketTx)).(*SocketTx), nil
+}
+
+// Liquidity decodes txData into a DecodedLiquidity.
+func (s *Socket) Liquidity(txData []byte) (DecodedLiquidity, error) {
+	tx, err := s.Decode(txData)
+	if err != nil {
+		return DecodedLiquidity{}, err
+	}
+	return tx.Liquidity(), nil
+}
+
+// Validate checks args.TxData like SocketValidator.validateTxData: the bridge route must not be blacklisted, the
+// bridge rules apply and the request must spend args.Token.
+func (s *Socket) Validate(args Args) (bool, error) {
+	tx, err := s.Decode(args.TxData)
+	if err != nil {
+		return false, err
+	}
+	if s.Blacklisted(tx.BridgeRequest.Id) {
+		return false, fmt.Errorf("%w: route %s", ErrBlacklistedRouteID, tx.BridgeRequest.Id)
+	}
+	hasDstSwap, err := validateBridge(args, tx.ReceiverAddress, tx.ToChainId)
+	if err != nil {
+		return false, err
+	}
+	return hasDstSwap, checkToken(tx.Token(), args.Token)
+}
+
+// SocketOneInch decodes and validates the performDirectAction calldata of Socket's 1inch implementation the way
+// SocketOneInchValidator does. It is a same chain swap.
+type SocketOneInch struct{}
+
+// Liquidity decodes txData. Like SocketOneInchValidator it does not check the selector.
+func (SocketOneInch) Liquidity(txData []byte) (DecodedLiquidity, error) {
+	if len(txData) < 4 {
+		return DecodedLiquidity{}, fmt.Errorf("%w: %d bytes", ErrMalformed, len(txData))
+	}
+	out, err := socketSwapInputArgs.Unpack(txData[4:])
+	if err != nil {
+		return DecodedLiquidity{}, fmt.Errorf("%w: swap input: %v", ErrMalformed, err)
+	}
+	return DecodedLiquidity{
+		InputToken:  out[0].(common.Address),
+		OutputToken: out[1].(common.Address),
+		Receiver:    out[2].(common.Address),
+		Amount:      out[3].(*big.Int),
+	}, nil
+}
+
+// Validate checks args.TxData like SocketOneInchValidator.validateTxData: the swap rules apply and the swap must
+// spend args.Token.
+func (s SocketOneInch) Validate(args Args) (bool, error) {
+	l, err := s.Liquidity(args.TxData)
+	if err != nil {
+		return false, err
+	}
+	if err := validateSwap(args, l.Receiver); err != nil {
+		return false, err
+	}
+	return false, checkToken(l.InputToken, args.Token)
+}
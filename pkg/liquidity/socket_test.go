@@ -0,0 +1,152 @@
+package liquidity
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	socketOutboundTransferTo  = selector("outboundTransferTo((address,uint256,uint256,(uint256,uint256,address,bytes),(uint256,uint256,address,bytes)))")
+	socketPerformDirectAction = selector("performDirectAction(address,address,address,uint256,bytes)")
+)
+
+func testSocketTx(receiver common.Address, toChainID int64, token common.Address, middleware bool) SocketTx {
+	tx := SocketTx{
+		ReceiverAddress:   receiver,
+		ToChainId:         big.NewInt(toChainID),
+		Amount:            big.NewInt(1000),
+		MiddlewareRequest: SocketRequest{Id: new(big.Int), OptionalNativeAmount: new(big.Int), Data: []byte{}},
+		BridgeRequest:     SocketRequest{Id: big.NewInt(7), OptionalNativeAmount: new(big.Int), InputToken: token, Data: []byte{}},
+	}
+	if middleware {
+		tx.MiddlewareRequest = SocketRequest{Id: big.NewInt(3), OptionalNativeAmount: new(big.Int), InputToken: token, Data: []byte{0x01}}
+		tx.BridgeRequest.InputToken = testInterim
+	}
+	return tx
+}
+
+func TestSocketDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   SocketTx
+		want DecodedLiquidity
+	}{
+		{
+			name: "bridge",
+			tx:   testSocketTx(testRegistry, 8453, testToken, false),
+			want: DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+		{
+			name: "middleware swap spends its input token",
+			tx:   testSocketTx(testRegistry, 8453, testToken, true),
+			want: DecodedLiquidity{InputToken: testToken, Amount: big.NewInt(1000), Receiver: testRegistry, DstChainID: big.NewInt(8453)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSocket().Liquidity(pack(t, socketOutboundTransferTo, socketUserRequestArgs, tt.tx))
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkLiquidity(t, got, tt.want)
+		})
+	}
+}
+
+func TestSocketValidate(t *testing.T) {
+	blacklisted := NewSocket()
+	blacklisted.Blacklist(big.NewInt(7))
+	interim := depositArgs(nil)
+	interim.InterimToken = testInterim
+
+	tests := []struct {
+		name        string
+		socket      *Socket
+		args        *Args
+		tx          SocketTx
+		txData      []byte
+		wantErr     error
+		wantDstSwap bool
+	}{
+		{name: "too short", txData: []byte{0x01}, wantErr: ErrMalformed},
+		{name: "truncated", txData: append(socketOutboundTransferTo[:], make([]byte, 40)...), wantErr: ErrMalformed},
+		// each case breaks every later rule too, so the first failing rule is the one reported
+		{name: "blacklisted route first", socket: blacklisted, tx: testSocketTx(testUser, 1, testInterim, false), wantErr: ErrBlacklistedRouteID},
+		{name: "chain id before receiver", tx: testSocketTx(testUser, 1, testInterim, false), wantErr: ErrChainID},
+		{name: "receiver before token", tx: testSocketTx(testUser, 8453, testInterim, false), wantErr: ErrReceiver},
+		{name: "token", tx: testSocketTx(testRegistry, 8453, testInterim, false), wantErr: ErrToken},
+		{name: "middleware token", tx: testSocketTx(testRegistry, 8453, testToken, true)},
+		{name: "dst swap needs interim token", tx: testSocketTx(testSwapper, 8453, testToken, false), wantErr: ErrInterimToken},
+		{name: "dst swap", args: &interim, tx: testSocketTx(testSwapper, 8453, testToken, false), wantDstSwap: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.socket
+			if s == nil {
+				s = NewSocket()
+			}
+			txData := tt.txData
+			if txData == nil {
+				txData = pack(t, socketOutboundTransferTo, socketUserRequestArgs, tt.tx)
+			}
+			args := depositArgs(txData)
+			if tt.args != nil {
+				args = *tt.args
+				args.TxData = txData
+			}
+			hasDstSwap, err := s.Validate(args)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			if hasDstSwap != tt.wantDstSwap {
+				t.Fatalf("hasDstSwap %v, want %v", hasDstSwap, tt.wantDstSwap)
+			}
+		})
+	}
+}
+
+func TestSocketOneInch(t *testing.T) {
+	swap := func(from, to, receiver common.Address) []byte {
+		return pack(t, socketPerformDirectAction, socketSwapInputArgs, from, to, receiver, big.NewInt(1000), []byte{0x01})
+	}
+	got, err := SocketOneInch{}.Liquidity(swap(testToken, testInterim, testSuperform))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkLiquidity(t, got, DecodedLiquidity{InputToken: testToken, OutputToken: testInterim, Amount: big.NewInt(1000), Receiver: testSuperform})
+
+	sameChain := depositArgs(nil)
+	sameChain.DstChainID, sameChain.LiqDstChainID = 10, 10
+	liqDstChain := sameChain
+	liqDstChain.LiqDstChainID = 8453
+	withdrawal := depositArgs(nil)
+	withdrawal.Deposit = false
+
+	tests := []struct {
+		name    string
+		args    Args
+		txData  []byte
+		wantErr error
+	}{
+		{name: "too short", args: sameChain, txData: []byte{0x01}, wantErr: ErrMalformed},
+		{name: "cross chain deposit", args: depositArgs(nil), txData: swap(testInterim, testToken, testUser), wantErr: ErrChainID},
+		{name: "liqDstChainId", args: liqDstChain, txData: swap(testInterim, testToken, testUser), wantErr: ErrDepositLiqDstChainID},
+		{name: "receiver before token", args: sameChain, txData: swap(testInterim, testToken, testUser), wantErr: ErrReceiver},
+		{name: "token", args: sameChain, txData: swap(testInterim, testToken, testSuperform), wantErr: ErrToken},
+		{name: "deposit", args: sameChain, txData: swap(testToken, testInterim, testSuperform)},
+		{name: "withdrawal receiver", args: withdrawal, txData: swap(testToken, testInterim, testSuperform), wantErr: ErrReceiver},
+		{name: "withdrawal", args: withdrawal, txData: swap(testToken, testInterim, testUser)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			args.TxData = tt.txData
+			if _, err := (SocketOneInch{}).Validate(args); !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Package metadata serves ERC1155 metadata JSON for SuperPositions.
+//
+// SuperPositions.uri(id) is dynamicURI followed by the decimal id, so the server is mounted at the path of the
+// configured base URI and answers <base><id> with the superform metadata resolved through the catalog.
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/pkg/catalog"
+)
+
+// Metadata is the ERC1155 metadata JSON document of one SuperPosition.
+type Metadata struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Decimals    uint8      `json:"decimals"`
+	Properties  Properties `json:"properties"`
+}
+
+// Properties are the Superform specific fields of Metadata.
+type Properties struct {
+	SuperformID          string         `json:"superformId"`
+	ChainID              uint64         `json:"chainId"`
+	ChainName            string         `json:"chainName,omitempty"`
+	FormImplementationID uint32         `json:"formImplementationId"`
+	Superform            common.Address `json:"superform"`
+	Vault                common.Address `json:"vault"`
+	VaultName            string         `json:"vaultName"`
+	VaultSymbol          string         `json:"vaultSymbol"`
+	Asset                common.Address `json:"asset"`
+}
+
+// Server is an http.Handler answering metadata requests under the path of BaseURI.
+type Server struct {
+	catalog    *catalog.Catalog
+	baseURI    string
+	prefix     string
+	chainNames map[uint64]string
+	watcher    *Watcher
+}
+
+// NewServer creates a Server for the public baseURI SuperPositions should be configured with, e.g.
+// "https://api.example.com/superpositions/". chainNames labels chain ids in the output and may be nil.
+func NewServer(cat *catalog.Catalog, baseURI string, chainNames map[uint64]string) (*Server, error) {
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: base uri: %w", err)
+	}
+	prefix := u.Path
+	if !strings.HasSuffix(prefix, "/") {
+		// uri(id) appends the id directly, so a base without a trailing slash still needs a path segment to match
+		return nil, fmt.Errorf("metadata: base uri %q must end with '/'", baseURI)
+	}
+	return &Server{
+		catalog:    cat,
+		baseURI:    baseURI,
+		prefix:     prefix,
+		chainNames: chainNames,
+	}, nil
+}
+
+// BaseURI returns the dynamicURI the server routes match.
+func (s *Server) BaseURI() string {
+	return s.baseURI
+}
+
+// SetWatcher attaches the URI watcher whose status is reported on the health route.
+func (s *Server) SetWatcher(w *Watcher) {
+	s.watcher = w
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path == s.prefix+"health" {
+		s.serveHealth(w)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, s.prefix) {
+		http.NotFound(w, r)
+		return
+	}
+
+	id, ok := parseID(strings.TrimPrefix(r.URL.Path, s.prefix))
+	if !ok {
+		http.Error(w, "invalid token id", http.StatusBadRequest)
+		return
+	}
+
+	sf, err := s.catalog.Superform(r.Context(), id)
+	switch {
+	case errors.Is(err, catalog.ErrNotSuperform), errors.Is(err, catalog.ErrUnknownChain):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		log.Error("Failed to resolve superposition", "id", id, "err", err)
+		http.Error(w, "failed to resolve superform", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	json.NewEncoder(w).Encode(s.metadata(sf))
+}
+
+func (s *Server) metadata(sf catalog.Superform) Metadata {
+	chainName := s.chainNames[sf.ChainID]
+	description := fmt.Sprintf("SuperPosition of %s (%s)", sf.VaultName, sf.VaultSymbol)
+	if chainName != "" {
+		description += " on " + chainName
+	}
+	return Metadata{
+		Name:        sf.Name,
+		Description: description,
+		Decimals:    sf.VaultDecimals,
+		Properties: Properties{
+			SuperformID:          sf.ID.String(),
+			ChainID:              sf.ChainID,
+			ChainName:            chainName,
+			FormImplementationID: sf.FormImplementationID,
+			Superform:            sf.Superform,
+			Vault:                sf.Vault,
+			VaultName:            sf.VaultName,
+			VaultSymbol:          sf.VaultSymbol,
+			Asset:                sf.Asset,
+		},
+	}
+}
+
+func (s *Server) serveHealth(w http.ResponseWriter) {
+	status := http.StatusOK
+	var report []ChainStatus
+	if s.watcher != nil {
+		report = s.watcher.Status()
+		for _, st := range report {
+			if !st.Matches {
+				status = http.StatusServiceUnavailable
+			}
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		BaseURI string        `json:"baseURI"`
+		Chains  []ChainStatus `json:"chains"`
+	}{s.baseURI, report})
+}
+
+// parseID accepts the decimal id produced by uri(id), optionally followed by ".json", as well as the 0x prefixed
+// hex form. Superform ids are often 64 decimal digits long, so an unprefixed id is never read as hex.
+func parseID(s string) (*big.Int, bool) {
+	s = strings.TrimSuffix(s, ".json")
+	base := 10
+	if hex, ok := strings.CutPrefix(s, "0x"); ok {
+		s, base = hex, 16
+	}
+	if s == "" {
+		return nil, false
+	}
+	id, ok := new(big.Int).SetString(s, base)
+	if !ok || id.Sign() <= 0 {
+		return nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,42 @@
+package metadata
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseID(t *testing.T) {
+	// 64 decimal digits, which are also valid hex.
+	const long = "6277101735386680763835789423207666416102355444464034512896000001"
+	want, _ := new(big.Int).SetString(long, 10)
+
+	tests := []struct {
+		in   string
+		want *big.Int
+	}{
+		{"42", big.NewInt(42)},
+		{"42.json", big.NewInt(42)},
+		{long, want},
+		{long + ".json", want},
+		{"0x" + want.Text(16), want},
+		{"0x2a.json", big.NewInt(42)},
+		{"2a", nil},
+		{"0x", nil},
+		{"0", nil},
+		{"-1", nil},
+		{".json", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got, ok := parseID(tt.in)
+		if tt.want == nil {
+			if ok {
+				t.Errorf("parseID(%q) = %s, want rejected", tt.in, got)
+			}
+			continue
+		}
+		if !ok || got.Cmp(tt.want) != 0 {
+			t.Errorf("parseID(%q) = %s, %v, want %s", tt.in, got, ok, tt.want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package metadata
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ChainStatus is the last observed URI configuration of SuperPositions on one chain.
+type ChainStatus struct {
+	ChainID uint64 `json:"chainId"`
+	// DynamicURI is the on-chain base URI, empty until the first successful poll.
+	DynamicURI string `json:"dynamicURI"`
+	Frozen     bool   `json:"frozen"`
+	// Matches reports whether DynamicURI equals the base URI the server routes.
+	Matches bool `json:"matches"`
+	// Block is the last block scanned for DynamicURIUpdated events.
+	Block uint64 `json:"block"`
+	Error string `json:"error,omitempty"`
+}
+
+// WatchedChain is a SuperPositions deployment the Watcher follows.
+type WatchedChain struct {
+	ChainID        uint64
+	Backend        bind.ContractBackend
+	SuperPositions common.Address
+	// FromBlock is the first block scanned for DynamicURIUpdated events. Zero starts at the head seen by the first
+	// poll.
+	FromBlock uint64
+}
+
+// DefaultBlockRange is the number of blocks a Watcher queries logs for at once unless NewWatcher is given another.
+const DefaultBlockRange = 5000
+
+type watchedChain struct {
+	WatchedChain
+	positions *contracts.SuperPositions
+	status    ChainStatus
+}
+
+// Watcher follows DynamicURIUpdated and URI events on every chain and checks that the base URI SuperPositions
+// hands out still points at the server.
+type Watcher struct {
+	baseURI    string
+	baseHash   common.Hash
+	blockRange uint64
+
+	mu     sync.RWMutex
+	chains []*watchedChain
+}
+
+// NewWatcher creates a Watcher validating chains against baseURI, querying logs blockRange blocks at a time. A zero
+// blockRange uses DefaultBlockRange.
+func NewWatcher(baseURI string, chains []WatchedChain, blockRange uint64) (*Watcher, error) {
+	if blockRange == 0 {
+		blockRange = DefaultBlockRange
+	}
+	w := &Watcher{
+		baseURI:    baseURI,
+		baseHash:   crypto.Keccak256Hash([]byte(baseURI)),
+		blockRange: blockRange,
+	}
+	for _, c := range chains {
+		positions, err := contracts.NewSuperPositions(c.SuperPositions, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		w.chains = append(w.chains, &watchedChain{
+			WatchedChain: c,
+			positions:    positions,
+			status:       ChainStatus{ChainID: c.ChainID, Block: c.FromBlock},
+		})
+	}
+	sort.Slice(w.chains, func(i, j int) bool { return w.chains[i].ChainID < w.chains[j].ChainID })
+	return w, nil
+}
+
+// Status returns the status of every chain, ordered by chain id.
+func (w *Watcher) Status() []ChainStatus {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	out := make([]ChainStatus, len(w.chains))
+	for i, c := range w.chains {
+		out[i] = c.status
+	}
+	return out
+}
+
+// Run polls every chain each interval until ctx is done.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		w.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll checks every chain once.
+func (w *Watcher) Poll(ctx context.Context) {
+	for _, c := range w.chains {
+		status, err := w.poll(ctx, c)
+		if err != nil {
+			log.Warn("Failed to check SuperPositions uri", "chainId", c.ChainID, "err", err)
+			status.Error = err.Error()
+		}
+		w.mu.Lock()
+		c.status = status
+		w.mu.Unlock()
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context, c *watchedChain) (ChainStatus, error) {
+	w.mu.RLock()
+	status := c.status
+	w.mu.RUnlock()
+	status.Error = ""
+
+	head, err := c.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return status, err
+	}
+	to := head.Number.Uint64()
+	if status.Block == 0 {
+		status.Block = to
+	}
+
+	// Scan in chunks and keep the progress of the completed ones, so a long backlog is not restarted on error.
+	for status.Block <= to {
+		end := min(status.Block+w.blockRange-1, to)
+		if err := w.scan(ctx, c, status.Block, end); err != nil {
+			return status, err
+		}
+		status.Block = end + 1
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	if status.DynamicURI, err = c.positions.DynamicURI(opts); err != nil {
+		return status, err
+	}
+	if status.Frozen, err = c.positions.DynamicURIFrozen(opts); err != nil {
+		return status, err
+	}
+	status.Matches = status.DynamicURI == w.baseURI
+	if !status.Matches {
+		log.Warn("SuperPositions uri does not match server routes", "chainId", c.ChainID, "dynamicURI", status.DynamicURI, "baseURI", w.baseURI, "frozen", status.Frozen)
+	}
+	return status, nil
+}
+
+// scan reports DynamicURIUpdated events moving the base away from the server and per-id URI events pointing
+// outside of it. Both strings of DynamicURIUpdated are indexed, so only their hashes can be compared.
+func (w *Watcher) scan(ctx context.Context, c *watchedChain, from, to uint64) error {
+	opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+
+	updates, err := c.positions.FilterDynamicURIUpdated(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	for updates.Next() {
+		ev := updates.Event
+		if ev.NewURI == w.baseHash {
+			log.Info("SuperPositions uri updated to server base", "chainId", c.ChainID, "block", ev.Raw.BlockNumber, "frozen", ev.Frozen)
+		} else {
+			log.Warn("SuperPositions uri updated away from server base", "chainId", c.ChainID, "block", ev.Raw.BlockNumber, "tx", ev.Raw.TxHash, "newURIHash", ev.NewURI, "frozen", ev.Frozen)
+		}
+	}
+	if err := updates.Error(); err != nil {
+		updates.Close()
+		return err
+	}
+	updates.Close()
+
+	uris, err := c.positions.FilterURI(opts, nil)
+	if err != nil {
+		return err
+	}
+	defer uris.Close()
+	for uris.Next() {
+		ev := uris.Event
+		if ev.Value != w.baseURI+ev.Id.String() {
+			log.Warn("SuperPosition uri outside server base", "chainId", c.ChainID, "id", ev.Id, "uri", ev.Value, "block", ev.Raw.BlockNumber)
+		}
+	}
+	return uris.Error()
+}
@@ -0,0 +1,339 @@
+// Package migration moves plain ERC4626 vault shares held by a wallet into Superform through
+// SuperformRouterPlus.deposit4626.
+//
+// For every vault the wallet holds shares of, the package looks up a superform wrapping that same vault on the
+// local SuperformFactory, previews the redeem to derive ExpectedOutputAmount, encodes a
+// singleDirectSingleVaultDeposit call for SuperformRouter and returns the approve and deposit4626 transactions
+// that complete the migration.
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+// EntireSlippage mirrors ENTIRE_SLIPPAGE in the router contracts: 10000 = 100%.
+const EntireSlippage = 10_000
+
+// ERC4626FormImplementationID is the form implementation id ERC4626Form is registered under on every chain.
+const ERC4626FormImplementationID uint32 = 1
+
+var (
+	// ErrNoSuperform is returned when a vault has no superform for the requested form implementation.
+	ErrNoSuperform = errors.New("migration: vault has no matching superform")
+	// ErrInvalidSlippage is returned when the configured slippage is above EntireSlippage.
+	ErrInvalidSlippage = errors.New("migration: slippage must not exceed 10000")
+)
+
+// Config holds the chain-local addresses and parameters of a Migrator.
+type Config struct {
+	// ChainID is the chain the vaults, factory and routers live on.
+	ChainID uint64
+	// Factory is the SuperformFactory address.
+	Factory common.Address
+	// RouterPlus is the SuperformRouterPlus address, which pulls and redeems the vault shares.
+	RouterPlus common.Address
+	// FormImplementationID selects which superform to deposit into when a vault is wrapped by several forms.
+	// Defaults to ERC4626FormImplementationID.
+	FormImplementationID uint32
+	// MaxSlippage is applied to both the redeem and the superform deposit, in bps of EntireSlippage.
+	MaxSlippage uint64
+}
+
+// Position is an ERC4626 holding of a wallet together with the superform it can migrate into.
+type Position struct {
+	Vault  common.Address
+	Asset  common.Address
+	Shares *big.Int
+
+	// ExpectedOutputAmount is the vault previewRedeem of Shares, i.e. the assets RouterPlus will deposit.
+	ExpectedOutputAmount *big.Int
+	// OutputAmount is the superform previewDepositTo of ExpectedOutputAmount.
+	OutputAmount *big.Int
+
+	SuperformID *big.Int
+	Superform   common.Address
+}
+
+// Step is the transaction sequence migrating one position. Approve is nil when the wallet already granted
+// RouterPlus a sufficient allowance on the vault shares.
+type Step struct {
+	Position Position
+	Approve  *types.Transaction
+	Deposit  *types.Transaction
+}
+
+// Backend is the chain access a Migrator needs: contract calls, transactions and receipts.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Migrator scans wallets for migratable ERC4626 positions and builds their deposit4626 transactions.
+type Migrator struct {
+	backend    Backend
+	cfg        Config
+	factory    *contracts.SFFactory
+	routerPlus *contracts.SuperformRouterPlusTransactor
+	routerABI  *abi.ABI
+}
+
+// NewMigrator creates a Migrator bound to the factory and RouterPlus in cfg.
+func NewMigrator(backend Backend, cfg Config) (*Migrator, error) {
+	if cfg.MaxSlippage > EntireSlippage {
+		return nil, ErrInvalidSlippage
+	}
+	if cfg.FormImplementationID == 0 {
+		cfg.FormImplementationID = ERC4626FormImplementationID
+	}
+
+	factory, err := contracts.NewSFFactory(cfg.Factory, backend)
+	if err != nil {
+		return nil, err
+	}
+	routerPlus, err := contracts.NewSuperformRouterPlusTransactor(cfg.RouterPlus, backend)
+	if err != nil {
+		return nil, err
+	}
+	routerABI, err := contracts.SFRouterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		backend:    backend,
+		cfg:        cfg,
+		factory:    factory,
+		routerPlus: routerPlus,
+		routerABI:  routerABI,
+	}, nil
+}
+
+// Vaults lists every vault wrapped by a superform of the configured form implementation, from the factory's
+// SuperformCreated events since fromBlock. The result is the candidate set to Scan a wallet against.
+func (m *Migrator) Vaults(ctx context.Context, fromBlock uint64) ([]common.Address, error) {
+	formID := []*big.Int{new(big.Int).SetUint64(uint64(m.cfg.FormImplementationID))}
+	it, err := m.factory.FilterSuperformCreated(&bind.FilterOpts{Start: fromBlock, Context: ctx}, formID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	seen := make(map[common.Address]bool)
+	var vaults []common.Address
+	for it.Next() {
+		if !seen[it.Event.Vault] {
+			seen[it.Event.Vault] = true
+			vaults = append(vaults, it.Event.Vault)
+		}
+	}
+	return vaults, it.Error()
+}
+
+// Scan returns a Position for every vault in vaults that owner holds shares of and that is wrapped by a superform
+// of the configured form implementation. Vaults with a zero balance or without a matching superform are skipped.
+func (m *Migrator) Scan(ctx context.Context, owner common.Address, vaults []common.Address) ([]Position, error) {
+	var positions []Position
+	for _, vault := range vaults {
+		pos, err := m.Position(ctx, owner, vault)
+		if errors.Is(err, ErrNoSuperform) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("vault %s: %w", vault, err)
+		}
+		if pos.Shares.Sign() == 0 {
+			continue
+		}
+		positions = append(positions, pos)
+	}
+	return positions, nil
+}
+
+// Position reads the owner's share balance in vault and resolves the superform it migrates into.
+func (m *Migrator) Position(ctx context.Context, owner, vault common.Address) (Position, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	superformID, superform, err := m.findSuperform(opts, vault)
+	if err != nil {
+		return Position{}, err
+	}
+
+	v, err := contracts.NewIERC4626Caller(vault, m.backend)
+	if err != nil {
+		return Position{}, err
+	}
+	shares, err := v.BalanceOf(opts, owner)
+	if err != nil {
+		return Position{}, err
+	}
+	asset, err := v.Asset(opts)
+	if err != nil {
+		return Position{}, err
+	}
+
+	pos := Position{
+		Vault:                vault,
+		Asset:                asset,
+		Shares:               shares,
+		ExpectedOutputAmount: new(big.Int),
+		OutputAmount:         new(big.Int),
+		SuperformID:          superformID,
+		Superform:            superform,
+	}
+	if shares.Sign() == 0 {
+		return pos, nil
+	}
+
+	if pos.ExpectedOutputAmount, err = v.PreviewRedeem(opts, shares); err != nil {
+		return Position{}, err
+	}
+
+	form, err := contracts.NewERC4626FormCaller(superform, m.backend)
+	if err != nil {
+		return Position{}, err
+	}
+	if pos.OutputAmount, err = form.PreviewDepositTo(opts, pos.ExpectedOutputAmount); err != nil {
+		return Position{}, err
+	}
+	return pos, nil
+}
+
+func (m *Migrator) findSuperform(opts *bind.CallOpts, vault common.Address) (*big.Int, common.Address, error) {
+	res, err := m.factory.GetAllSuperformsFromVault(opts, vault)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	for i, id := range res.SuperformIds {
+		sf, err := datalib.GetSuperform(id)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		if sf.FormImplementationID == m.cfg.FormImplementationID && sf.ChainID == m.cfg.ChainID {
+			return id, res.Superforms[i], nil
+		}
+	}
+	return nil, common.Address{}, ErrNoSuperform
+}
+
+// DepositCallData encodes the SuperformRouter singleDirectSingleVaultDeposit call RouterPlus performs with the
+// redeemed assets. Superpositions are minted to receiverSP.
+func (m *Migrator) DepositCallData(pos Position, receiverSP common.Address) ([]byte, error) {
+	req := contracts.SingleDirectSingleVaultStateReq{
+		SuperformData: contracts.SingleVaultSFData{
+			SuperformId:  pos.SuperformID,
+			Amount:       pos.ExpectedOutputAmount,
+			OutputAmount: pos.OutputAmount,
+			MaxSlippage:  new(big.Int).SetUint64(m.cfg.MaxSlippage),
+			LiqRequest: contracts.LiqRequest{
+				TxData:        []byte{},
+				Token:         pos.Asset,
+				LiqDstChainId: m.cfg.ChainID,
+				NativeAmount:  new(big.Int),
+			},
+			Permit2data:       []byte{},
+			ReceiverAddress:   receiverSP,
+			ReceiverAddressSP: receiverSP,
+			ExtraFormData:     []byte{},
+		},
+	}
+	return m.routerABI.Pack("singleDirectSingleVaultDeposit", req)
+}
+
+// Args builds the deposit4626 arguments for pos.
+func (m *Migrator) Args(pos Position, receiverSP common.Address) (contracts.ISuperformRouterPlusDeposit4626Args, error) {
+	callData, err := m.DepositCallData(pos, receiverSP)
+	if err != nil {
+		return contracts.ISuperformRouterPlusDeposit4626Args{}, err
+	}
+	return contracts.ISuperformRouterPlusDeposit4626Args{
+		Amount:               pos.Shares,
+		ExpectedOutputAmount: pos.ExpectedOutputAmount,
+		MaxSlippage:          new(big.Int).SetUint64(m.cfg.MaxSlippage),
+		ReceiverAddressSP:    receiverSP,
+		DepositCallData:      callData,
+	}, nil
+}
+
+// Migrate creates, per position, the approve transaction granting RouterPlus the vault shares (when the current
+// allowance is short) followed by the deposit4626 transaction.
+//
+// Approvals are waited on before the deposit is sent so that its gas estimation succeeds. With opts.NoSend the
+// sequence is only signed: nonces are assigned locally from the pending nonce and opts.GasLimit must be set, since
+// a deposit cannot be estimated against an approval that has not landed. An explicit opts.Nonce is copied and
+// advanced after every transaction in either mode; the caller's value is left untouched.
+func (m *Migrator) Migrate(opts *bind.TransactOpts, positions []Position) ([]Step, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	txOpts := *opts
+	if txOpts.Nonce != nil {
+		txOpts.Nonce = new(big.Int).Set(opts.Nonce)
+	} else if opts.NoSend {
+		nonce, err := m.backend.PendingNonceAt(ctx, opts.From)
+		if err != nil {
+			return nil, err
+		}
+		txOpts.Nonce = new(big.Int).SetUint64(nonce)
+	}
+
+	steps := make([]Step, 0, len(positions))
+	for _, pos := range positions {
+		step, err := m.migrate(ctx, &txOpts, pos)
+		if err != nil {
+			return steps, fmt.Errorf("vault %s: %w", pos.Vault, err)
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func (m *Migrator) migrate(ctx context.Context, opts *bind.TransactOpts, pos Position) (Step, error) {
+	step := Step{Position: pos}
+
+	vault, err := contracts.NewIERC4626(pos.Vault, m.backend)
+	if err != nil {
+		return step, err
+	}
+	allowance, err := vault.Allowance(&bind.CallOpts{Context: ctx}, opts.From, m.cfg.RouterPlus)
+	if err != nil {
+		return step, err
+	}
+	if allowance.Cmp(pos.Shares) < 0 {
+		if step.Approve, err = vault.Approve(opts, m.cfg.RouterPlus, pos.Shares); err != nil {
+			return step, err
+		}
+		if opts.Nonce != nil {
+			opts.Nonce.Add(opts.Nonce, common.Big1)
+		}
+		if !opts.NoSend {
+			if _, err = bind.WaitMined(ctx, m.backend, step.Approve); err != nil {
+				return step, err
+			}
+		}
+	}
+
+	args, err := m.Args(pos, opts.From)
+	if err != nil {
+		return step, err
+	}
+	if step.Deposit, err = m.routerPlus.Deposit4626(opts, pos.Vault, args); err != nil {
+		return step, err
+	}
+	if opts.Nonce != nil {
+		opts.Nonce.Add(opts.Nonce, common.Big1)
+	}
+	return step, nil
+}
@@ -0,0 +1,253 @@
+// Package onboarding creates superforms in bulk from a manifest of (chain, vault, form implementation) entries.
+//
+// SuperformFactory.createSuperform reverts with VAULT_FORM_IMPLEMENTATION_COMBINATION_EXISTS when a vault is
+// already wrapped by the form implementation, so every entry is first checked against
+// vaultFormImplCombinationToSuperforms and the form implementation is checked to exist and not be paused. Only the
+// remaining entries are sent, and the superform ids are read back from the SuperformCreated events.
+package onboarding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Entry is one superform to onboard.
+type Entry struct {
+	ChainID              uint64         `json:"chainId"`
+	Vault                common.Address `json:"vault"`
+	FormImplementationID uint32         `json:"formImplementationId"`
+}
+
+// LoadManifest reads a JSON array of entries.
+func LoadManifest(path string) ([]Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("onboarding: manifest %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// Status is the outcome of checking or onboarding an entry.
+type Status uint8
+
+const (
+	// Pending entries pass every check and still need a createSuperform.
+	Pending Status = iota
+	// Exists entries already have a superform for the vault and form implementation.
+	Exists
+	// Created entries were onboarded by Run.
+	Created
+	// UnknownForm entries reference a form implementation id the factory does not have.
+	UnknownForm
+	// Paused entries reference a paused form implementation.
+	Paused
+	// Failed entries errored while checking or creating.
+	Failed
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Exists:
+		return "exists"
+	case Created:
+		return "created"
+	case UnknownForm:
+		return "unknown form"
+	case Paused:
+		return "paused"
+	case Failed:
+		return "failed"
+	default:
+		return fmt.Sprintf("Status(%d)", uint8(s))
+	}
+}
+
+// Item is an entry with its status. SuperformID and Superform are set for Exists and Created items.
+type Item struct {
+	Entry
+	Status      Status
+	SuperformID *big.Int
+	Superform   common.Address
+	TxHash      common.Hash
+	Err         error
+}
+
+// Backend is the chain access the onboarder needs.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Chain is the SuperformFactory of one chain.
+type Chain struct {
+	Backend Backend
+	Factory common.Address
+}
+
+// Onboarder checks and onboards manifest entries.
+type Onboarder struct {
+	chains map[uint64]Chain
+}
+
+// NewOnboarder creates an Onboarder over chains keyed by chain id.
+func NewOnboarder(chains map[uint64]Chain) *Onboarder {
+	return &Onboarder{chains: chains}
+}
+
+// combination mirrors the vaultFormImplCombinationToSuperforms key: keccak256(abi.encode(formImplementation, vault)).
+func combination(formImplementation, vault common.Address) ([32]byte, error) {
+	addr, _ := abi.NewType("address", "", nil)
+	packed, err := abi.Arguments{{Type: addr}, {Type: addr}}.Pack(formImplementation, vault)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return crypto.Keccak256Hash(packed), nil
+}
+
+// Plan checks every entry. Duplicate entries in the manifest are reported once.
+func (o *Onboarder) Plan(ctx context.Context, entries []Entry) []Item {
+	seen := make(map[Entry]bool)
+	var items []Item
+	for _, e := range entries {
+		if seen[e] {
+			continue
+		}
+		seen[e] = true
+		items = append(items, o.check(ctx, e))
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].ChainID < items[j].ChainID })
+	return items
+}
+
+func (o *Onboarder) check(ctx context.Context, e Entry) Item {
+	item := Item{Entry: e}
+	fail := func(err error) Item {
+		item.Status, item.Err = Failed, err
+		return item
+	}
+
+	c, ok := o.chains[e.ChainID]
+	if !ok {
+		return fail(fmt.Errorf("onboarding: unknown chain %d", e.ChainID))
+	}
+	factory, err := contracts.NewSFFactoryCaller(c.Factory, c.Backend)
+	if err != nil {
+		return fail(err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	impl, err := factory.GetFormImplementation(opts, e.FormImplementationID)
+	if err != nil {
+		return fail(err)
+	}
+	if impl == (common.Address{}) {
+		item.Status = UnknownForm
+		return item
+	}
+
+	key, err := combination(impl, e.Vault)
+	if err != nil {
+		return fail(err)
+	}
+	id, err := factory.VaultFormImplCombinationToSuperforms(opts, key)
+	if err != nil {
+		return fail(err)
+	}
+	if id.Sign() != 0 {
+		item.Status = Exists
+		item.SuperformID = id
+		sf, err := factory.GetSuperform(opts, id)
+		if err != nil {
+			return fail(err)
+		}
+		item.Superform = sf.Superform
+		return item
+	}
+
+	paused, err := factory.IsFormImplementationPaused(opts, e.FormImplementationID)
+	if err != nil {
+		return fail(err)
+	}
+	if paused {
+		item.Status = Paused
+		return item
+	}
+	item.Status = Pending
+	return item
+}
+
+// Run sends createSuperform for every Pending item using the transact options of its chain, waits for each to be
+// mined and fills in the created superform. Items on chains without options are left Pending.
+func (o *Onboarder) Run(ctx context.Context, opts map[uint64]*bind.TransactOpts, items []Item) []Item {
+	out := make([]Item, len(items))
+	copy(out, items)
+
+	for i := range out {
+		item := &out[i]
+		txOpts, ok := opts[item.ChainID]
+		if item.Status != Pending || !ok {
+			continue
+		}
+		if err := o.create(ctx, txOpts, item); err != nil {
+			item.Status, item.Err = Failed, err
+			log.Warn("Failed to create superform", "chainId", item.ChainID, "vault", item.Vault, "formImplementationId", item.FormImplementationID, "err", err)
+			continue
+		}
+		log.Info("Created superform", "chainId", item.ChainID, "vault", item.Vault, "formImplementationId", item.FormImplementationID, "superformId", item.SuperformID, "superform", item.Superform)
+	}
+	return out
+}
+
+func (o *Onboarder) create(ctx context.Context, opts *bind.TransactOpts, item *Item) error {
+	c := o.chains[item.ChainID]
+	factory, err := contracts.NewSFFactory(c.Factory, c.Backend)
+	if err != nil {
+		return err
+	}
+
+	tx, err := factory.CreateSuperform(opts, item.FormImplementationID, item.Vault)
+	if err != nil {
+		return err
+	}
+	item.TxHash = tx.Hash()
+
+	receipt, err := bind.WaitMined(ctx, c.Backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("onboarding: createSuperform reverted in %s", tx.Hash())
+	}
+	for _, l := range receipt.Logs {
+		ev, err := factory.ParseSuperformCreated(*l)
+		if err != nil || l.Address != c.Factory {
+			continue
+		}
+		if ev.Vault == item.Vault && ev.FormImplementationId.Uint64() == uint64(item.FormImplementationID) {
+			item.Status = Created
+			item.SuperformID = ev.SuperformId
+			item.Superform = ev.Superform
+			return nil
+		}
+	}
+	return fmt.Errorf("onboarding: no SuperformCreated event in %s", tx.Hash())
+}
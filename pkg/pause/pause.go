@@ -0,0 +1,235 @@
+// Package pause pauses or unpauses a form implementation on every chain.
+//
+// SuperformFactory.changeFormImplementationPauseStatus sets the status locally and, when extraData_ is set,
+// broadcasts it through BroadcastRegistry; every other factory applies it in stateSyncBroadcast and emits
+// FormImplementationPaused. The broadcast AMB parameters are the ones PaymentHelper holds for broadcasts
+// (getRegisterTransmuterAMBData), so the same quote is used to encode extraData_ and fund msg.value. Convergence is
+// confirmed on each destination through both the FormImplementationPaused event and the formImplementationPaused
+// view.
+package pause
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/broadcast"
+)
+
+// Status mirrors ISuperformFactory.PauseStatus.
+type Status uint8
+
+const (
+	NonPaused Status = iota
+	Paused
+)
+
+func (s Status) String() string {
+	switch s {
+	case NonPaused:
+		return "NON_PAUSED"
+	case Paused:
+		return "PAUSED"
+	default:
+		return fmt.Sprintf("PauseStatus(%d)", uint8(s))
+	}
+}
+
+// Chain is the access the orchestrator needs to one chain.
+type Chain struct {
+	Backend       bind.ContractBackend
+	Factory       common.Address
+	PaymentHelper common.Address
+}
+
+// Orchestrator changes pause statuses and tracks their propagation.
+type Orchestrator struct {
+	chains map[uint64]Chain
+}
+
+// NewOrchestrator creates an Orchestrator over chains keyed by chain id.
+func NewOrchestrator(chains map[uint64]Chain) *Orchestrator {
+	return &Orchestrator{chains: chains}
+}
+
+func (o *Orchestrator) chain(chainID uint64) (Chain, error) {
+	c, ok := o.chains[chainID]
+	if !ok {
+		return Chain{}, fmt.Errorf("pause: unknown chain %d", chainID)
+	}
+	return c, nil
+}
+
+// Quote returns the broadcast extra data PaymentHelper on chainID quotes. Its GasFee is the msg.value the status
+// change must carry.
+func (o *Orchestrator) Quote(ctx context.Context, chainID uint64) (broadcast.ExtraData, error) {
+	c, err := o.chain(chainID)
+	if err != nil {
+		return broadcast.ExtraData{}, err
+	}
+	helper, err := contracts.NewPaymentHelperCaller(c.PaymentHelper, c.Backend)
+	if err != nil {
+		return broadcast.ExtraData{}, err
+	}
+	data, err := helper.GetRegisterTransmuterAMBData(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return broadcast.ExtraData{}, err
+	}
+	if len(data) == 0 {
+		return broadcast.ExtraData{}, fmt.Errorf("pause: PaymentHelper on chain %d has no broadcast parameters", chainID)
+	}
+	return broadcast.DecodeExtraData(data)
+}
+
+// Set changes the status of formImplementationID on srcChainID from opts.From, which must hold
+// EMERGENCY_ADMIN_ROLE, and broadcasts it to every other chain. The broadcast is skipped when srcChainID is the only
+// configured chain. opts.Value defaults to the quoted gas fee.
+func (o *Orchestrator) Set(opts *bind.TransactOpts, srcChainID uint64, formImplementationID uint32, status Status) (*types.Transaction, error) {
+	c, err := o.chain(srcChainID)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := contracts.NewSFFactoryTransactor(c.Factory, c.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	txOpts := *opts
+	var extraData []byte
+	if len(o.chains) > 1 {
+		quote, err := o.Quote(opts.Context, srcChainID)
+		if err != nil {
+			return nil, err
+		}
+		if extraData, err = quote.Encode(); err != nil {
+			return nil, err
+		}
+		if txOpts.Value == nil {
+			txOpts.Value = quote.GasFee
+		} else if !quote.Covers(txOpts.Value) {
+			return nil, fmt.Errorf("pause: value %s below broadcast gas fee %s", txOpts.Value, quote.GasFee)
+		}
+	}
+
+	tx, err := factory.ChangeFormImplementationPauseStatus(&txOpts, formImplementationID, uint8(status), extraData)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Changed form implementation pause status", "chainId", srcChainID, "formImplementationId", formImplementationID, "status", status, "broadcast", len(extraData) != 0, "tx", tx.Hash())
+	return tx, nil
+}
+
+// ChainStatus is the observed status of the form implementation on one chain.
+type ChainStatus struct {
+	ChainID uint64
+	// Status is the formImplementationPaused view.
+	Status Status
+	// Event is the last FormImplementationPaused event since the start block, nil when none was emitted.
+	Event *contracts.SFFactoryFormImplementationPaused
+	// Converged is set when both the view and the last event report the wanted status.
+	Converged bool
+}
+
+// Check reads the status of formImplementationID on every chain. fromBlocks gives the block to scan events from
+// per chain, typically the heads right before Set; chains missing from it are scanned from genesis.
+func (o *Orchestrator) Check(ctx context.Context, formImplementationID uint32, want Status, fromBlocks map[uint64]uint64) ([]ChainStatus, error) {
+	ids := make([]uint64, 0, len(o.chains))
+	for id := range o.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	out := make([]ChainStatus, 0, len(ids))
+	for _, id := range ids {
+		st, err := o.check(ctx, id, formImplementationID, want, fromBlocks[id])
+		if err != nil {
+			return nil, fmt.Errorf("chain %d: %w", id, err)
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+func (o *Orchestrator) check(ctx context.Context, chainID uint64, formImplementationID uint32, want Status, fromBlock uint64) (ChainStatus, error) {
+	c := o.chains[chainID]
+	factory, err := contracts.NewSFFactory(c.Factory, c.Backend)
+	if err != nil {
+		return ChainStatus{}, err
+	}
+
+	st := ChainStatus{ChainID: chainID}
+	view, err := factory.FormImplementationPaused(&bind.CallOpts{Context: ctx}, formImplementationID)
+	if err != nil {
+		return ChainStatus{}, err
+	}
+	st.Status = Status(view)
+
+	it, err := factory.FilterFormImplementationPaused(
+		&bind.FilterOpts{Start: fromBlock, Context: ctx},
+		[]*big.Int{new(big.Int).SetUint64(uint64(formImplementationID))}, nil,
+	)
+	if err != nil {
+		return ChainStatus{}, err
+	}
+	defer it.Close()
+	for it.Next() {
+		ev := *it.Event
+		st.Event = &ev
+	}
+	if err := it.Error(); err != nil {
+		return ChainStatus{}, err
+	}
+
+	st.Converged = st.Status == want && st.Event != nil && Status(st.Event.Paused) == want
+	return st, nil
+}
+
+// Wait polls Check every interval until every chain converged or ctx is done.
+func (o *Orchestrator) Wait(ctx context.Context, formImplementationID uint32, want Status, fromBlocks map[uint64]uint64, interval time.Duration) ([]ChainStatus, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := o.Check(ctx, formImplementationID, want, fromBlocks)
+		if err != nil {
+			return nil, err
+		}
+		pending := 0
+		for _, st := range report {
+			if !st.Converged {
+				pending++
+			}
+		}
+		if pending == 0 {
+			return report, nil
+		}
+		log.Info("Waiting for pause status to converge", "formImplementationId", formImplementationID, "status", want, "pending", pending)
+
+		select {
+		case <-ctx.Done():
+			return report, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Heads returns the current block of every chain, for use as Check fromBlocks before calling Set.
+func (o *Orchestrator) Heads(ctx context.Context) (map[uint64]uint64, error) {
+	heads := make(map[uint64]uint64, len(o.chains))
+	for id, c := range o.chains {
+		header, err := c.Backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("chain %d: %w", id, err)
+		}
+		heads[id] = header.Number.Uint64()
+	}
+	return heads, nil
+}
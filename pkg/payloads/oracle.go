@@ -0,0 +1,327 @@
+package payloads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrInconsistent is returned in Checked mode when Decode and PayloadHelper disagree on a payload.
+var ErrInconsistent = errors.New("payloads: offline decoding differs from PayloadHelper")
+
+// Mode selects how Oracle.Payload decodes.
+type Mode uint8
+
+const (
+	// OnChain returns the PayloadHelper decoding.
+	OnChain Mode = iota
+	// Checked also decodes the payload offline and returns ErrInconsistent when the two differ.
+	Checked
+)
+
+// Mismatch is a field on which the offline decoding and PayloadHelper disagree.
+type Mismatch struct {
+	PayloadID *big.Int
+	// Field names the Payload field, e.g. "Amounts" or "LiqData[1].Token".
+	Field   string
+	Offline string
+	OnChain string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("payload %s: %s is %s offline, %s on chain", m.PayloadID, m.Field, m.Offline, m.OnChain)
+}
+
+// Single is the single vault view PayloadHelper returns for timelock, sync withdraw and async ack payloads.
+type Single struct {
+	// Account is the receiverAddress of timelock and sync withdraw payloads, the srcSender of failed timelock and
+	// async ack payloads.
+	Account      common.Address
+	SrcChainID   uint64
+	SrcPayloadID *big.Int
+	SuperformID  *big.Int
+	Amount       *big.Int
+}
+
+// History is PayloadHelper.decodePayloadHistory: the source side record SuperPositions keeps of a payload.
+type History struct {
+	TxType       TxType
+	CallbackType CallbackType
+	Multi        bool
+	SrcSender    common.Address
+	// ReceiverAddressSP is who receives the SuperPositions of the payload.
+	ReceiverAddressSP common.Address
+	SrcChainID        uint64
+}
+
+// Oracle reads CoreStateRegistry payloads through PayloadHelper. The views shared by both versions are read with
+// the PayloadHelperV2 binding; TimelockPayload and TimelockFailedPayload only exist on the first version,
+// SyncWithdrawPayload and AsyncAckPayload on the second.
+type Oracle struct {
+	helper   *contracts.PayloadHelperV2Caller
+	helperV1 *contracts.PayloadHelperCaller
+	registry *contracts.CoreStateRegistryCaller
+	mode     Mode
+}
+
+// NewOracle binds the PayloadHelper at helper and the CoreStateRegistry it decodes.
+func NewOracle(backend bind.ContractCaller, helper, coreStateRegistry common.Address, mode Mode) (*Oracle, error) {
+	h, err := contracts.NewPayloadHelperV2Caller(helper, backend)
+	if err != nil {
+		return nil, err
+	}
+	h1, err := contracts.NewPayloadHelperCaller(helper, backend)
+	if err != nil {
+		return nil, err
+	}
+	r, err := contracts.NewCoreStateRegistryCaller(coreStateRegistry, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Oracle{helper: h, helperV1: h1, registry: r, mode: mode}, nil
+}
+
+// Payload returns payload id as decoded by PayloadHelper, with the liquidity requests of Init payloads. In Checked
+// mode it also decodes the payload offline and fails with ErrInconsistent when they differ.
+func (o *Oracle) Payload(ctx context.Context, id *big.Int) (Payload, error) {
+	p, err := o.onChain(ctx, id)
+	if err != nil {
+		return Payload{}, err
+	}
+	if o.mode != Checked {
+		return p, nil
+	}
+	mismatches, err := o.check(ctx, id, p)
+	if err != nil {
+		return Payload{}, err
+	}
+	if len(mismatches) > 0 {
+		return Payload{}, fmt.Errorf("%w: %s", ErrInconsistent, mismatches[0])
+	}
+	return p, nil
+}
+
+// Decode decodes payload id offline from the registry's payloadHeader and payloadBody.
+func (o *Oracle) Decode(ctx context.Context, id *big.Int) (Payload, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	header, err := o.registry.PayloadHeader(opts, id)
+	if err != nil {
+		return Payload{}, fmt.Errorf("payloads: payloadHeader %s: %w", id, err)
+	}
+	body, err := o.registry.PayloadBody(opts, id)
+	if err != nil {
+		return Payload{}, fmt.Errorf("payloads: payloadBody %s: %w", id, err)
+	}
+	return Decode(id, header, body)
+}
+
+// Check decodes payload id both offline and through PayloadHelper, and returns the fields they disagree on,
+// including the payload proof. Each mismatch is logged.
+func (o *Oracle) Check(ctx context.Context, id *big.Int) ([]Mismatch, error) {
+	p, err := o.onChain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return o.check(ctx, id, p)
+}
+
+// Proof returns PayloadHelper.getDstPayloadProof for payload id.
+func (o *Oracle) Proof(ctx context.Context, id *big.Int) (common.Hash, error) {
+	proof, err := o.helper.GetDstPayloadProof(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("payloads: getDstPayloadProof %s: %w", id, err)
+	}
+	return proof, nil
+}
+
+// History returns the SuperPositions record of the source payload srcPayloadID.
+func (o *Oracle) History(ctx context.Context, srcPayloadID *big.Int) (History, error) {
+	out, err := o.helper.DecodePayloadHistory(&bind.CallOpts{Context: ctx}, srcPayloadID)
+	if err != nil {
+		return History{}, fmt.Errorf("payloads: decodePayloadHistory %s: %w", srcPayloadID, err)
+	}
+	return History{
+		TxType:            TxType(out.TxType),
+		CallbackType:      CallbackType(out.CallbackType),
+		Multi:             out.Multi == 1,
+		SrcSender:         out.SrcSender,
+		ReceiverAddressSP: out.ReceiverAddressSP,
+		SrcChainID:        out.SrcChainId,
+	}, nil
+}
+
+// TimelockPayload returns the TimelockStateRegistry withdrawal id. It needs the first PayloadHelper version.
+func (o *Oracle) TimelockPayload(ctx context.Context, id *big.Int) (Single, error) {
+	out, err := o.helperV1.DecodeTimeLockPayload(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return Single{}, fmt.Errorf("payloads: decodeTimeLockPayload %s: %w", id, err)
+	}
+	return Single{Account: out.ReceiverAddress, SrcChainID: out.SrcChainId, SrcPayloadID: out.SrcPayloadId, SuperformID: out.SuperformId, Amount: out.Amount}, nil
+}
+
+// TimelockFailedPayload returns the failed withdrawal acknowledgement id of the TimelockStateRegistry. It needs the
+// first PayloadHelper version.
+func (o *Oracle) TimelockFailedPayload(ctx context.Context, id *big.Int) (Single, error) {
+	out, err := o.helperV1.DecodeTimeLockFailedPayload(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return Single{}, fmt.Errorf("payloads: decodeTimeLockFailedPayload %s: %w", id, err)
+	}
+	return Single{Account: out.SrcSender, SrcChainID: out.SrcChainId, SrcPayloadID: out.SrcPayloadId, SuperformID: out.SuperformId, Amount: out.Amount}, nil
+}
+
+// SyncWithdrawPayload returns the AsyncStateRegistry sync withdraw txData payload id. It needs PayloadHelperV2.
+func (o *Oracle) SyncWithdrawPayload(ctx context.Context, id *big.Int) (Single, error) {
+	out, err := o.helper.DecodeSyncWithdrawPayload(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return Single{}, fmt.Errorf("payloads: decodeSyncWithdrawPayload %s: %w", id, err)
+	}
+	return Single{Account: out.ReceiverAddress, SrcChainID: out.SrcChainId, SrcPayloadID: out.SrcPayloadId, SuperformID: out.SuperformId, Amount: out.Amount}, nil
+}
+
+// AsyncAckPayload returns the AsyncStateRegistry acknowledgement payload id. It needs PayloadHelperV2.
+func (o *Oracle) AsyncAckPayload(ctx context.Context, id *big.Int) (Single, error) {
+	out, err := o.helper.DecodeAsyncAckPayload(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return Single{}, fmt.Errorf("payloads: decodeAsyncAckPayload %s: %w", id, err)
+	}
+	return Single{Account: out.SrcSender, SrcChainID: out.SrcChainId, SrcPayloadID: out.SrcPayloadId, SuperformID: out.SuperformId, Amount: out.Amount}, nil
+}
+
+// onChain reads payload id through decodeCoreStateRegistryPayload and, for Init payloads,
+// decodeCoreStateRegistryPayloadLiqData.
+func (o *Oracle) onChain(ctx context.Context, id *big.Int) (Payload, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	v, err := o.helper.DecodeCoreStateRegistryPayload(opts, id)
+	if err != nil {
+		return Payload{}, fmt.Errorf("payloads: decodeCoreStateRegistryPayload %s: %w", id, err)
+	}
+	p := Payload{
+		ID:              id,
+		TxType:          TxType(v.TxType),
+		CallbackType:    CallbackType(v.CallbackType),
+		Multi:           v.Multi == 1,
+		SrcSender:       v.SrcSender,
+		SrcChainID:      v.SrcChainId,
+		SrcPayloadID:    v.SrcPayloadId,
+		SuperformIDs:    v.SuperformIds,
+		Amounts:         v.Amounts,
+		OutputAmounts:   v.OutputAmounts,
+		MaxSlippages:    v.Slippages,
+		HasDstSwaps:     v.HasDstSwaps,
+		Retain4626s:     v.Retain4626,
+		ReceiverAddress: v.ReceiverAddress,
+		ExtraFormData:   v.ExtraFormData,
+	}
+	if p.CallbackType != Init {
+		return p, nil
+	}
+	liq, err := o.helper.DecodeCoreStateRegistryPayloadLiqData(opts, id)
+	if err != nil {
+		return Payload{}, fmt.Errorf("payloads: decodeCoreStateRegistryPayloadLiqData %s: %w", id, err)
+	}
+	for i := range liq.TxDatas {
+		p.LiqData = append(p.LiqData, contracts.LiqRequest{
+			TxData:        liq.TxDatas[i],
+			Token:         liq.Tokens[i],
+			InterimToken:  liq.InterimTokens[i],
+			BridgeId:      liq.BridgeIds[i],
+			LiqDstChainId: liq.LiqDstChainIds[i],
+			NativeAmount:  liq.NativeAmounts[i],
+		})
+	}
+	p.AmountsIn = liq.AmountsIn
+	return p, nil
+}
+
+// check compares onChain with the offline decoding of payload id and the helper's proof with the offline one.
+func (o *Oracle) check(ctx context.Context, id *big.Int, onChain Payload) ([]Mismatch, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	header, err := o.registry.PayloadHeader(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("payloads: payloadHeader %s: %w", id, err)
+	}
+	body, err := o.registry.PayloadBody(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("payloads: payloadBody %s: %w", id, err)
+	}
+	offline, err := Decode(id, header, body)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := Proof(header, body)
+	if err != nil {
+		return nil, err
+	}
+	helperProof, err := o.Proof(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := compare(offline, onChain)
+	if proof != helperProof {
+		mismatches = append(mismatches, Mismatch{PayloadID: id, Field: "Proof", Offline: proof.Hex(), OnChain: helperProof.Hex()})
+	}
+	for _, m := range mismatches {
+		log.Warn("Payload decoding mismatch", "payloadId", id, "field", m.Field, "offline", m.Offline, "onChain", m.OnChain)
+	}
+	return mismatches, nil
+}
+
+// compare returns the fields of offline and onChain that differ. PayloadHelper leaves the superform ids of Return
+// and Fail payloads unset, so they are only compared for Init payloads.
+func compare(offline, onChain Payload) []Mismatch {
+	var out []Mismatch
+	diff := func(field string, a, b any) {
+		if sa, sb := format(a), format(b); sa != sb {
+			out = append(out, Mismatch{PayloadID: offline.ID, Field: field, Offline: sa, OnChain: sb})
+		}
+	}
+	diff("TxType", offline.TxType, onChain.TxType)
+	diff("CallbackType", offline.CallbackType, onChain.CallbackType)
+	diff("Multi", offline.Multi, onChain.Multi)
+	diff("SrcSender", offline.SrcSender, onChain.SrcSender)
+	diff("SrcChainID", offline.SrcChainID, onChain.SrcChainID)
+	diff("SrcPayloadID", offline.SrcPayloadID, onChain.SrcPayloadID)
+	diff("Amounts", offline.Amounts, onChain.Amounts)
+	if offline.CallbackType != Init {
+		return out
+	}
+	diff("SuperformIDs", offline.SuperformIDs, onChain.SuperformIDs)
+	diff("OutputAmounts", offline.OutputAmounts, onChain.OutputAmounts)
+	diff("MaxSlippages", offline.MaxSlippages, onChain.MaxSlippages)
+	diff("HasDstSwaps", offline.HasDstSwaps, onChain.HasDstSwaps)
+	diff("Retain4626s", offline.Retain4626s, onChain.Retain4626s)
+	diff("ReceiverAddress", offline.ReceiverAddress, onChain.ReceiverAddress)
+	diff("ExtraFormData", offline.ExtraFormData, onChain.ExtraFormData)
+	if len(offline.LiqData) != len(onChain.LiqData) {
+		diff("len(LiqData)", len(offline.LiqData), len(onChain.LiqData))
+		return out
+	}
+	for i, a := range offline.LiqData {
+		b := onChain.LiqData[i]
+		diff(fmt.Sprintf("LiqData[%d].TxData", i), a.TxData, b.TxData)
+		diff(fmt.Sprintf("LiqData[%d].Token", i), a.Token, b.Token)
+		diff(fmt.Sprintf("LiqData[%d].InterimToken", i), a.InterimToken, b.InterimToken)
+		diff(fmt.Sprintf("LiqData[%d].BridgeId", i), a.BridgeId, b.BridgeId)
+		diff(fmt.Sprintf("LiqData[%d].LiqDstChainId", i), a.LiqDstChainId, b.LiqDstChainId)
+		diff(fmt.Sprintf("LiqData[%d].NativeAmount", i), a.NativeAmount, b.NativeAmount)
+	}
+	return out
+}
+
+// format prints v for comparison: byte slices as hex, everything else with its default format, so that nil and
+// empty slices compare equal.
+func format(v any) string {
+	if b, ok := v.([]byte); ok {
+		return hexutil.Encode(b)
+	}
+	return fmt.Sprint(v)
+}
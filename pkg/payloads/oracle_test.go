@@ -0,0 +1,290 @@
+package payloads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+var (
+	testHelper    = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testRegistry  = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testSrcSender = common.HexToAddress("0x3333333333333333333333333333333333333333")
+	testReceiver  = common.HexToAddress("0x4444444444444444444444444444444444444444")
+	testToken     = common.HexToAddress("0x0b2C639c533813f4Aa9D7837CAf62653d097Ff85")
+	testInterim   = common.HexToAddress("0xDA10009cBd5D07dd0CeCc66161FC93D7c9000da1")
+)
+
+// liqDataOut are the outputs of decodeCoreStateRegistryPayloadLiqData.
+type liqDataOut struct {
+	TxDatas        [][]byte
+	Tokens         []common.Address
+	InterimTokens  []common.Address
+	BridgeIds      []uint8
+	LiqDstChainIds []uint64
+	AmountsIn      []*big.Int
+	NativeAmounts  []*big.Int
+}
+
+// fakeChain answers the CoreStateRegistry and PayloadHelperV2 views Oracle reads for one payload: the registry
+// storage and what the deployed helper returns for it.
+type fakeChain struct {
+	header  *big.Int
+	body    []byte
+	decoded contracts.IPayloadHelperDecodedDstPayload
+	liqData liqDataOut
+	proof   common.Hash
+}
+
+func (*fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (c *fakeChain) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	metadata := contracts.PayloadHelperV2MetaData
+	if *call.To == testRegistry {
+		metadata = contracts.CoreStateRegistryMetaData
+	}
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "payloadHeader":
+		return method.Outputs.Pack(c.header)
+	case "payloadBody":
+		return method.Outputs.Pack(c.body)
+	case "decodeCoreStateRegistryPayload":
+		return method.Outputs.Pack(c.decoded)
+	case "decodeCoreStateRegistryPayloadLiqData":
+		l := c.liqData
+		return method.Outputs.Pack(l.TxDatas, l.Tokens, l.InterimTokens, l.BridgeIds, l.LiqDstChainIds, l.AmountsIn, l.NativeAmounts)
+	case "getDstPayloadProof":
+		return method.Outputs.Pack(c.proof)
+	default:
+		return nil, fmt.Errorf("unexpected call %s", method.Name)
+	}
+}
+
+// word returns v as a 32 byte abi word.
+func word(v *big.Int) []byte {
+	return common.LeftPadBytes(v.Bytes(), 32)
+}
+
+// encodeMessage hand encodes abi.encode(AMBMessage(header, body)), the preimage of the payload proof.
+func encodeMessage(header *big.Int, body []byte) []byte {
+	out := append(word(big.NewInt(0x20)), word(header)...)
+	out = append(out, word(big.NewInt(0x40))...)
+	out = append(out, word(big.NewInt(int64(len(body))))...)
+	return append(out, common.RightPadBytes(body, (len(body)+31)/32*32)...)
+}
+
+func ints(v ...int64) []*big.Int {
+	out := make([]*big.Int, len(v))
+	for i, x := range v {
+		out[i] = big.NewInt(x)
+	}
+	return out
+}
+
+// depositFixture is a stored two vault deposit from chain 10, the second vault with a dst swap from testInterim.
+func depositFixture(t *testing.T) *fakeChain {
+	superformIDs := []*big.Int{
+		datalib.PackSuperform(common.HexToAddress("0x5555555555555555555555555555555555555555"), 1, 8453),
+		datalib.PackSuperform(common.HexToAddress("0x6666666666666666666666666666666666666666"), 1, 8453),
+	}
+	liqData := []contracts.LiqRequest{
+		{TxData: []byte{}, Token: testToken, BridgeId: 1, LiqDstChainId: 8453, NativeAmount: new(big.Int)},
+		{TxData: []byte{}, Token: testToken, InterimToken: testInterim, BridgeId: 101, LiqDstChainId: 8453, NativeAmount: big.NewInt(7)},
+	}
+	header := datalib.PackTxInfo(datalib.TxInfo{TxType: uint8(Deposit), CallbackType: uint8(Init), Multi: 1, RegistryID: 1, SrcSender: testSrcSender, SrcChainID: 10})
+	body, err := multiVaultArgs.Pack(initMultiVaultData{
+		PayloadId:       big.NewInt(42),
+		SuperformIds:    superformIDs,
+		Amounts:         ints(1000, 2000),
+		OutputAmounts:   ints(990, 1980),
+		MaxSlippages:    ints(50, 100),
+		LiqData:         liqData,
+		HasDstSwaps:     []bool{false, true},
+		Retain4626s:     []bool{false, false},
+		ReceiverAddress: testReceiver,
+		ExtraFormData:   []byte{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeChain{
+		header: header,
+		body:   body,
+		decoded: contracts.IPayloadHelperDecodedDstPayload{
+			TxType:          uint8(Deposit),
+			CallbackType:    uint8(Init),
+			SrcSender:       testSrcSender,
+			SrcChainId:      10,
+			Amounts:         ints(1000, 2000),
+			OutputAmounts:   ints(990, 1980),
+			Slippages:       ints(50, 100),
+			SuperformIds:    superformIDs,
+			HasDstSwaps:     []bool{false, true},
+			ReceiverAddress: testReceiver,
+			SrcPayloadId:    big.NewInt(42),
+			ExtraFormData:   []byte{},
+			Multi:           1,
+			Retain4626:      []bool{false, false},
+		},
+		liqData: liqDataOut{
+			TxDatas:        [][]byte{{}, {}},
+			Tokens:         []common.Address{testToken, testToken},
+			InterimTokens:  []common.Address{{}, testInterim},
+			BridgeIds:      []uint8{1, 101},
+			LiqDstChainIds: []uint64{8453, 8453},
+			AmountsIn:      ints(0, 0),
+			NativeAmounts:  ints(0, 7),
+		},
+		proof: crypto.Keccak256Hash(encodeMessage(header, body)),
+	}
+}
+
+// ackFixture is a stored single vault deposit acknowledgement from chain 8453. PayloadHelper leaves the superform
+// ids of such payloads unset.
+func ackFixture(t *testing.T) *fakeChain {
+	header := datalib.PackTxInfo(datalib.TxInfo{TxType: uint8(Deposit), CallbackType: uint8(Return), RegistryID: 1, SrcSender: testSrcSender, SrcChainID: 8453})
+	body, err := returnSingleArgs.Pack(returnSingleData{PayloadId: big.NewInt(42), SuperformId: big.NewInt(9), Amount: big.NewInt(990)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeChain{
+		header: header,
+		body:   body,
+		decoded: contracts.IPayloadHelperDecodedDstPayload{
+			TxType:        uint8(Deposit),
+			CallbackType:  uint8(Return),
+			SrcSender:     testSrcSender,
+			SrcChainId:    8453,
+			Amounts:       ints(990),
+			SrcPayloadId:  big.NewInt(42),
+			ExtraFormData: []byte{},
+		},
+		proof: crypto.Keccak256Hash(encodeMessage(header, body)),
+	}
+}
+
+func TestOracleChecked(t *testing.T) {
+	tests := []struct {
+		name      string
+		chain     func(t *testing.T) *fakeChain
+		mode      Mode
+		wantErr   error
+		wantField string
+	}{
+		{name: "multi vault deposit", chain: depositFixture, mode: Checked},
+		{name: "deposit acknowledgement", chain: ackFixture, mode: Checked},
+		{
+			name: "amounts differ",
+			chain: func(t *testing.T) *fakeChain {
+				c := depositFixture(t)
+				c.decoded.Amounts = ints(1000, 2001)
+				return c
+			},
+			mode:      Checked,
+			wantErr:   ErrInconsistent,
+			wantField: "Amounts",
+		},
+		{
+			name: "liquidity request differs",
+			chain: func(t *testing.T) *fakeChain {
+				c := depositFixture(t)
+				c.liqData.InterimTokens = []common.Address{{}, testToken}
+				return c
+			},
+			mode:      Checked,
+			wantErr:   ErrInconsistent,
+			wantField: "LiqData[1].InterimToken",
+		},
+		{
+			name: "proof differs",
+			chain: func(t *testing.T) *fakeChain {
+				c := depositFixture(t)
+				c.proof = common.Hash{0x01}
+				return c
+			},
+			mode:      Checked,
+			wantErr:   ErrInconsistent,
+			wantField: "Proof",
+		},
+		{
+			name: "on chain mode does not check",
+			chain: func(t *testing.T) *fakeChain {
+				c := depositFixture(t)
+				c.decoded.Amounts = ints(1000, 2001)
+				return c
+			},
+			mode: OnChain,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := tt.chain(t)
+			o, err := NewOracle(chain, testHelper, testRegistry, tt.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+			id := big.NewInt(3)
+			p, err := o.Payload(ctx, id)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			mismatches, err := o.Check(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantField == "" {
+				if tt.mode == Checked && len(mismatches) > 0 {
+					t.Fatalf("mismatches %v", mismatches)
+				}
+				if p.ID.Cmp(id) != 0 || p.SrcSender != testSrcSender || p.SrcPayloadID.Cmp(big.NewInt(42)) != 0 {
+					t.Fatalf("got %+v", p)
+				}
+				return
+			}
+			if len(mismatches) != 1 || mismatches[0].Field != tt.wantField {
+				t.Fatalf("mismatches %v, want one on %s", mismatches, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestDecodeMessage(t *testing.T) {
+	chain := depositFixture(t)
+	m, err := DecodeMessage(encodeMessage(chain.header, chain.body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.TxInfo.Cmp(chain.header) != 0 || string(m.Params) != string(chain.body) {
+		t.Fatalf("got %+v", m)
+	}
+	proof, err := Proof(m.TxInfo, m.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof != chain.proof {
+		t.Fatalf("proof %s, want %s", proof, chain.proof)
+	}
+	if _, err := DecodeMessage(chain.body[:40]); err == nil {
+		t.Fatal("decoded a truncated message")
+	}
+}
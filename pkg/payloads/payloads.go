@@ -0,0 +1,266 @@
+// Package payloads decodes CoreStateRegistry payloads.
+//
+// Decode works offline on the payloadHeader and payloadBody a registry stores. Oracle reads the same payloads
+// through the deployed PayloadHelper, whose views decode them on chain, and can check the two agree.
+package payloads
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/datalib"
+)
+
+// ErrInvalidPayload mirrors INVALID_PAYLOAD, returned for a callback type the helper does not decode.
+var ErrInvalidPayload = errors.New("payloads: invalid payload")
+
+// TxType mirrors the TransactionType enum.
+type TxType uint8
+
+const (
+	Deposit TxType = iota
+	Withdraw
+)
+
+func (t TxType) String() string {
+	switch t {
+	case Deposit:
+		return "DEPOSIT"
+	case Withdraw:
+		return "WITHDRAW"
+	default:
+		return fmt.Sprintf("TxType(%d)", uint8(t))
+	}
+}
+
+// CallbackType mirrors the CallbackType enum.
+type CallbackType uint8
+
+const (
+	// Init is a deposit or withdraw request from the source chain.
+	Init CallbackType = iota
+	// Return acknowledges a cross chain deposit.
+	Return
+	// Fail reports a failed cross chain withdrawal.
+	Fail
+)
+
+func (c CallbackType) String() string {
+	switch c {
+	case Init:
+		return "INIT"
+	case Return:
+		return "RETURN"
+	case Fail:
+		return "FAIL"
+	default:
+		return fmt.Sprintf("CallbackType(%d)", uint8(c))
+	}
+}
+
+var (
+	liqRequestComponents = []abi.ArgumentMarshaling{
+		{Name: "txData", Type: "bytes"},
+		{Name: "token", Type: "address"},
+		{Name: "interimToken", Type: "address"},
+		{Name: "bridgeId", Type: "uint8"},
+		{Name: "liqDstChainId", Type: "uint64"},
+		{Name: "nativeAmount", Type: "uint256"},
+	}
+	singleVaultArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "payloadId", Type: "uint256"},
+		{Name: "superformId", Type: "uint256"},
+		{Name: "amount", Type: "uint256"},
+		{Name: "outputAmount", Type: "uint256"},
+		{Name: "maxSlippage", Type: "uint256"},
+		{Name: "liqData", Type: "tuple", Components: liqRequestComponents},
+		{Name: "hasDstSwap", Type: "bool"},
+		{Name: "retain4626", Type: "bool"},
+		{Name: "receiverAddress", Type: "address"},
+		{Name: "extraFormData", Type: "bytes"},
+	})}}
+	multiVaultArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "payloadId", Type: "uint256"},
+		{Name: "superformIds", Type: "uint256[]"},
+		{Name: "amounts", Type: "uint256[]"},
+		{Name: "outputAmounts", Type: "uint256[]"},
+		{Name: "maxSlippages", Type: "uint256[]"},
+		{Name: "liqData", Type: "tuple[]", Components: liqRequestComponents},
+		{Name: "hasDstSwaps", Type: "bool[]"},
+		{Name: "retain4626s", Type: "bool[]"},
+		{Name: "receiverAddress", Type: "address"},
+		{Name: "extraFormData", Type: "bytes"},
+	})}}
+	returnSingleArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "payloadId", Type: "uint256"},
+		{Name: "superformId", Type: "uint256"},
+		{Name: "amount", Type: "uint256"},
+	})}}
+	returnMultiArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "payloadId", Type: "uint256"},
+		{Name: "superformIds", Type: "uint256[]"},
+		{Name: "amounts", Type: "uint256[]"},
+	})}}
+	ambMessageArgs = abi.Arguments{{Type: mustType("tuple", []abi.ArgumentMarshaling{
+		{Name: "txInfo", Type: "uint256"},
+		{Name: "params", Type: "bytes"},
+	})}}
+)
+
+// initMultiVaultData mirrors the InitMultiVaultData struct.
+type initMultiVaultData struct {
+	PayloadId       *big.Int
+	SuperformIds    []*big.Int
+	Amounts         []*big.Int
+	OutputAmounts   []*big.Int
+	MaxSlippages    []*big.Int
+	LiqData         []contracts.LiqRequest
+	HasDstSwaps     []bool
+	Retain4626s     []bool
+	ReceiverAddress common.Address
+	ExtraFormData   []byte
+}
+
+// returnSingleData mirrors the ReturnSingleData struct.
+type returnSingleData struct {
+	PayloadId   *big.Int
+	SuperformId *big.Int
+	Amount      *big.Int
+}
+
+// returnMultiData mirrors the ReturnMultiData struct.
+type returnMultiData struct {
+	PayloadId    *big.Int
+	SuperformIds []*big.Int
+	Amounts      []*big.Int
+}
+
+// Payload is a decoded CoreStateRegistry payload. Single vault payloads fill every slice with one element, like
+// PayloadHelper does.
+type Payload struct {
+	ID           *big.Int
+	TxType       TxType
+	CallbackType CallbackType
+	Multi        bool
+	SrcSender    common.Address
+	SrcChainID   uint64
+	// SrcPayloadID is the payload id on the source chain, which SuperPositions.txHistory is keyed by.
+	SrcPayloadID *big.Int
+	SuperformIDs []*big.Int
+	Amounts      []*big.Int
+	// OutputAmounts, MaxSlippages, HasDstSwaps, Retain4626s, ReceiverAddress, ExtraFormData and LiqData are only
+	// set for Init payloads.
+	OutputAmounts   []*big.Int
+	MaxSlippages    []*big.Int
+	HasDstSwaps     []bool
+	Retain4626s     []bool
+	ReceiverAddress common.Address
+	ExtraFormData   []byte
+	LiqData         []contracts.LiqRequest
+	// AmountsIn is the amount each LiqData.TxData spends, as decoded by the bridge validators. Only Oracle sets
+	// it; it is zero for an empty txData.
+	AmountsIn []*big.Int
+}
+
+// IsDeposit reports whether p is a deposit request.
+func (p Payload) IsDeposit() bool {
+	return p.TxType == Deposit && p.CallbackType == Init
+}
+
+// IsWithdraw reports whether p is a withdraw request.
+func (p Payload) IsWithdraw() bool {
+	return p.TxType == Withdraw && p.CallbackType == Init
+}
+
+// Decode decodes payload id from its payloadHeader and payloadBody, like PayloadHelper.decodeCoreStateRegistryPayload
+// and decodeCoreStateRegistryPayloadLiqData.
+func Decode(id, header *big.Int, body []byte) (Payload, error) {
+	info := datalib.DecodeTxInfo(header)
+	p := Payload{
+		ID:           id,
+		TxType:       TxType(info.TxType),
+		CallbackType: CallbackType(info.CallbackType),
+		Multi:        info.Multi == 1,
+		SrcSender:    info.SrcSender,
+		SrcChainID:   info.SrcChainID,
+	}
+	switch {
+	case p.CallbackType == Init && p.Multi:
+		out, err := multiVaultArgs.Unpack(body)
+		if err != nil {
+			return Payload{}, fmt.Errorf("payloads: payload %s: %w", id, err)
+		}
+		data := *abi.ConvertType(out[0], new(initMultiVaultData)).(*initMultiVaultData)
+		p.SrcPayloadID, p.SuperformIDs, p.Amounts, p.OutputAmounts = data.PayloadId, data.SuperformIds, data.Amounts, data.OutputAmounts
+		p.MaxSlippages, p.HasDstSwaps, p.Retain4626s = data.MaxSlippages, data.HasDstSwaps, data.Retain4626s
+		p.ReceiverAddress, p.ExtraFormData, p.LiqData = data.ReceiverAddress, data.ExtraFormData, data.LiqData
+	case p.CallbackType == Init:
+		out, err := singleVaultArgs.Unpack(body)
+		if err != nil {
+			return Payload{}, fmt.Errorf("payloads: payload %s: %w", id, err)
+		}
+		data := *abi.ConvertType(out[0], new(contracts.InitSingleVaultData)).(*contracts.InitSingleVaultData)
+		p.SrcPayloadID = data.PayloadId
+		p.SuperformIDs = []*big.Int{data.SuperformId}
+		p.Amounts = []*big.Int{data.Amount}
+		p.OutputAmounts = []*big.Int{data.OutputAmount}
+		p.MaxSlippages = []*big.Int{data.MaxSlippage}
+		p.HasDstSwaps = []bool{data.HasDstSwap}
+		p.Retain4626s = []bool{data.Retain4626}
+		p.ReceiverAddress, p.ExtraFormData = data.ReceiverAddress, data.ExtraFormData
+		p.LiqData = []contracts.LiqRequest{data.LiqData}
+	case (p.CallbackType == Return || p.CallbackType == Fail) && p.Multi:
+		out, err := returnMultiArgs.Unpack(body)
+		if err != nil {
+			return Payload{}, fmt.Errorf("payloads: payload %s: %w", id, err)
+		}
+		data := *abi.ConvertType(out[0], new(returnMultiData)).(*returnMultiData)
+		p.SrcPayloadID, p.SuperformIDs, p.Amounts = data.PayloadId, data.SuperformIds, data.Amounts
+	case p.CallbackType == Return || p.CallbackType == Fail:
+		out, err := returnSingleArgs.Unpack(body)
+		if err != nil {
+			return Payload{}, fmt.Errorf("payloads: payload %s: %w", id, err)
+		}
+		data := *abi.ConvertType(out[0], new(returnSingleData)).(*returnSingleData)
+		p.SrcPayloadID = data.PayloadId
+		p.SuperformIDs = []*big.Int{data.SuperformId}
+		p.Amounts = []*big.Int{data.Amount}
+	default:
+		return Payload{}, fmt.Errorf("%w: payload %s has callback type %s", ErrInvalidPayload, id, p.CallbackType)
+	}
+	return p, nil
+}
+
+// DecodeMessage decodes abi.encode(AMBMessage), the message_ a state registry hands the AMB adapters and the
+// encoding Proof hashes.
+func DecodeMessage(message []byte) (contracts.AMBMessage, error) {
+	out, err := ambMessageArgs.Unpack(message)
+	if err != nil {
+		return contracts.AMBMessage{}, fmt.Errorf("payloads: message: %w", err)
+	}
+	return *abi.ConvertType(out[0], new(contracts.AMBMessage)).(*contracts.AMBMessage), nil
+}
+
+// Proof mirrors PayloadHelper.getDstPayloadProof: the hash of abi.encode(AMBMessage(header, body)) the registry
+// counts proofs under.
+func Proof(header *big.Int, body []byte) (common.Hash, error) {
+	encoded, err := ambMessageArgs.Pack(contracts.AMBMessage{TxInfo: header, Params: body})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func mustType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
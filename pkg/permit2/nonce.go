@@ -0,0 +1,119 @@
+package permit2
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nonceBitmapABI is the slice of the Permit2 SignatureTransfer interface needed to track unordered nonces.
+const nonceBitmapABI = `[{"type":"function","name":"nonceBitmap","inputs":[{"name":"","type":"address","internalType":"address"},{"name":"","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"}]`
+
+// maxWordScan bounds how many bitmap words Next inspects before giving up.
+const maxWordScan = 1 << 16
+
+// ErrNoncesExhausted is returned when no free nonce was found within the scanned bitmap words.
+var ErrNoncesExhausted = errors.New("permit2: no unused nonce found")
+
+// Nonces hands out Permit2 unordered nonces for one owner.
+//
+// Permit2 stores used nonces in a bitmap keyed by word position (nonce >> 8), with the low 8 bits of the nonce
+// selecting the bit. Nonces reads the on-chain words and remembers the nonces it handed out, so concurrent
+// permits signed before their transfers land do not collide.
+type Nonces struct {
+	contract *bind.BoundContract
+	owner    common.Address
+
+	mu       sync.Mutex
+	wordPos  *big.Int
+	reserved map[string]bool
+}
+
+// NewNonces creates a nonce tracker for owner against the Permit2 contract at permit2, starting the search at
+// word position startWord.
+func NewNonces(permit2, owner common.Address, backend bind.ContractCaller, startWord *big.Int) (*Nonces, error) {
+	parsed, err := abi.JSON(strings.NewReader(nonceBitmapABI))
+	if err != nil {
+		return nil, err
+	}
+	if startWord == nil {
+		startWord = new(big.Int)
+	}
+	return &Nonces{
+		contract: bind.NewBoundContract(permit2, parsed, backend, nil, nil),
+		owner:    owner,
+		wordPos:  new(big.Int).Set(startWord),
+		reserved: make(map[string]bool),
+	}, nil
+}
+
+// Bitmap returns the on-chain used-nonce bitmap word at wordPos.
+func (n *Nonces) Bitmap(ctx context.Context, wordPos *big.Int) (*big.Int, error) {
+	var out []interface{}
+	if err := n.contract.Call(&bind.CallOpts{Context: ctx}, &out, "nonceBitmap", n.owner, wordPos); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// IsUsed reports whether nonce was already consumed on-chain.
+func (n *Nonces) IsUsed(ctx context.Context, nonce *big.Int) (bool, error) {
+	wordPos, bitPos := split(nonce)
+	bitmap, err := n.Bitmap(ctx, wordPos)
+	if err != nil {
+		return false, err
+	}
+	return bitmap.Bit(bitPos) == 1, nil
+}
+
+// Next reserves and returns the lowest nonce that is neither used on-chain nor handed out before.
+func (n *Nonces) Next(ctx context.Context) (*big.Int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for i := 0; i < maxWordScan; i++ {
+		bitmap, err := n.Bitmap(ctx, n.wordPos)
+		if err != nil {
+			return nil, err
+		}
+		for bit := 0; bit < 256; bit++ {
+			if bitmap.Bit(bit) == 1 {
+				continue
+			}
+			nonce := join(n.wordPos, bit)
+			if n.reserved[nonce.String()] {
+				continue
+			}
+			n.reserved[nonce.String()] = true
+			return nonce, nil
+		}
+		n.wordPos.Add(n.wordPos, common.Big1)
+	}
+	return nil, ErrNoncesExhausted
+}
+
+// Release returns a reserved nonce that was never signed over, making it available to Next again.
+func (n *Nonces) Release(nonce *big.Int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.reserved, nonce.String())
+	if wordPos, _ := split(nonce); wordPos.Cmp(n.wordPos) < 0 {
+		n.wordPos.Set(wordPos)
+	}
+}
+
+func split(nonce *big.Int) (*big.Int, int) {
+	return new(big.Int).Rsh(nonce, 8), int(new(big.Int).And(nonce, big.NewInt(0xff)).Int64())
+}
+
+func join(wordPos *big.Int, bit int) *big.Int {
+	nonce := new(big.Int).Lsh(wordPos, 8)
+	return nonce.Or(nonce, big.NewInt(int64(bit)))
+}
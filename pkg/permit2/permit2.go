@@ -0,0 +1,230 @@
+// Package permit2 builds the Permit2 signature transfers accepted in SingleVaultSFData.Permit2data and
+// MultiVaultSFData.Permit2data.
+//
+// The router consumes permit2data as abi.encode(uint256 nonce, uint256 deadline, bytes signature) and calls
+// IPermit2.permitTransferFrom with itself as spender and the token/amount taken from the liquidity request, so
+// the signed PermitTransferFrom must name the router as spender and the exact amount the router pulls.
+package permit2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// CanonicalAddress is the Permit2 deployment address shared by every supported chain.
+var CanonicalAddress = common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3")
+
+var (
+	// ErrNativeToken is returned when a permit is requested for the native token, which the router never pulls.
+	ErrNativeToken = errors.New("permit2: native token cannot be permitted")
+	// ErrTxDataAmount is returned when the permitted amount cannot be derived because the liquidity request
+	// carries txData, whose amountIn is only known to the bridge validator.
+	ErrTxDataAmount = errors.New("permit2: amount must be supplied for liquidity requests with txData")
+	// ErrMixedTokens is returned when the liquidity requests of a multi vault deposit use different tokens.
+	ErrMixedTokens = errors.New("permit2: multi vault liquidity requests must share one token")
+	// ErrAmountsLength is returned when a multi vault deposit does not have one amount per liquidity request.
+	ErrAmountsLength = errors.New("permit2: amounts do not match liquidity requests")
+)
+
+// nativeToken mirrors the NATIVE sentinel used by the router.
+var nativeToken = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"PermitTransferFrom": {
+		{Name: "permitted", Type: "TokenPermissions"},
+		{Name: "spender", Type: "address"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+	"TokenPermissions": {
+		{Name: "token", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+	},
+}
+
+var permit2dataArgs = abi.Arguments{
+	{Type: mustType("uint256")},
+	{Type: mustType("uint256")},
+	{Type: mustType("bytes")},
+}
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// PermitTransferFrom is a single token Permit2 signature transfer.
+type PermitTransferFrom struct {
+	Token    common.Address
+	Amount   *big.Int
+	Spender  common.Address
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+// TypedData returns the EIP-712 typed data of the permit for the Permit2 contract at permit2 on chainID.
+func (p PermitTransferFrom) TypedData(chainID uint64, permit2 common.Address) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: "PermitTransferFrom",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Permit2",
+			ChainId:           math.NewHexOrDecimal256(int64(chainID)),
+			VerifyingContract: permit2.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"permitted": map[string]interface{}{
+				"token":  p.Token.Hex(),
+				"amount": p.Amount,
+			},
+			"spender":  p.Spender.Hex(),
+			"nonce":    p.Nonce,
+			"deadline": p.Deadline,
+		},
+	}
+}
+
+// Hash returns the EIP-712 digest that must be signed by the token owner.
+func (p PermitTransferFrom) Hash(chainID uint64, permit2 common.Address) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(p.TypedData(chainID, permit2))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// Encode packs a nonce, deadline and signature in the permit2data layout the router decodes.
+func Encode(nonce, deadline *big.Int, signature []byte) ([]byte, error) {
+	return permit2dataArgs.Pack(nonce, deadline, signature)
+}
+
+// Decode unpacks router permit2data into its nonce, deadline and signature.
+func Decode(permit2data []byte) (nonce, deadline *big.Int, signature []byte, err error) {
+	vals, err := permit2dataArgs.Unpack(permit2data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return vals[0].(*big.Int), vals[1].(*big.Int), vals[2].([]byte), nil
+}
+
+// Builder signs router Permit2 transfers for one owner on one chain.
+type Builder struct {
+	chainID uint64
+	permit2 common.Address
+	router  common.Address
+	signer  Signer
+	nonces  *Nonces
+}
+
+// NewBuilder creates a Builder authorising router, the spender calling permitTransferFrom, to pull tokens from
+// the signer's address through the Permit2 contract at permit2. Nonces are drawn from nonces, which must track
+// the same owner and Permit2 contract.
+func NewBuilder(chainID uint64, permit2, router common.Address, signer Signer, nonces *Nonces) *Builder {
+	return &Builder{
+		chainID: chainID,
+		permit2: permit2,
+		router:  router,
+		signer:  signer,
+		nonces:  nonces,
+	}
+}
+
+// Sign reserves a fresh nonce, signs a permit for amount of token valid until deadline and returns the encoded
+// permit2data.
+func (b *Builder) Sign(ctx context.Context, token common.Address, amount, deadline *big.Int) ([]byte, error) {
+	if token == nativeToken {
+		return nil, ErrNativeToken
+	}
+	nonce, err := b.nonces.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permit := PermitTransferFrom{
+		Token:    token,
+		Amount:   amount,
+		Spender:  b.router,
+		Nonce:    nonce,
+		Deadline: deadline,
+	}
+	hash, err := permit.Hash(b.chainID, b.permit2)
+	if err != nil {
+		b.nonces.Release(nonce)
+		return nil, err
+	}
+	sig, err := b.signer.SignHash(ctx, hash)
+	if err != nil {
+		b.nonces.Release(nonce)
+		return nil, fmt.Errorf("permit2: signing: %w", err)
+	}
+	return Encode(nonce, deadline, sig)
+}
+
+// SignSingleVault fills sf.Permit2data with a permit for the amount the router pulls for a single vault deposit.
+// amount may be nil when the liquidity request carries no txData, in which case sf.Amount is used.
+func (b *Builder) SignSingleVault(ctx context.Context, sf *contracts.SingleVaultSFData, amount, deadline *big.Int) error {
+	if amount == nil {
+		if len(sf.LiqRequest.TxData) != 0 {
+			return ErrTxDataAmount
+		}
+		amount = sf.Amount
+	}
+	data, err := b.Sign(ctx, sf.LiqRequest.Token, amount, deadline)
+	if err != nil {
+		return err
+	}
+	sf.Permit2data = data
+	return nil
+}
+
+// SignMultiVault fills sf.Permit2data with a permit for the total the router pulls for a multi vault deposit.
+// amountsIn may be nil when no liquidity request carries txData, in which case sf.Amounts is used. Either must have
+// one amount per liquidity request.
+func (b *Builder) SignMultiVault(ctx context.Context, sf *contracts.MultiVaultSFData, amountsIn []*big.Int, deadline *big.Int) error {
+	if len(sf.LiqRequests) == 0 {
+		return errors.New("permit2: no liquidity requests")
+	}
+	token := sf.LiqRequests[0].Token
+	for _, liq := range sf.LiqRequests {
+		if liq.Token != token {
+			return ErrMixedTokens
+		}
+		if amountsIn == nil && len(liq.TxData) != 0 {
+			return ErrTxDataAmount
+		}
+	}
+	if amountsIn == nil {
+		amountsIn = sf.Amounts
+	}
+	if len(amountsIn) != len(sf.LiqRequests) {
+		return fmt.Errorf("%w: %d amounts, %d liquidity requests", ErrAmountsLength, len(amountsIn), len(sf.LiqRequests))
+	}
+
+	total := new(big.Int)
+	for _, amount := range amountsIn {
+		total.Add(total, amount)
+	}
+	data, err := b.Sign(ctx, token, total, deadline)
+	if err != nil {
+		return err
+	}
+	sf.Permit2data = data
+	return nil
+}
@@ -0,0 +1,155 @@
+package permit2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Published Permit2 constants: the type hashes of PermitHash and the mainnet DOMAIN_SEPARATOR of the canonical
+// deployment.
+var (
+	tokenPermissionsTypehash   = common.HexToHash("0x618358ac3db8dc274f0cd8829da7e234bd48cd73c4a740aede1adec9846d06a1")
+	permitTransferFromTypehash = common.HexToHash("0x939c21a48a8dbe3a9a2404a1d46691e4d39f6583d6ec6b35714604c986d80106")
+	mainnetDomainSeparator     = common.HexToHash("0x866a5aba21966af95d6c7ab78eb2b2fc913915c28be3b9aa07cc04ff903e3f28")
+)
+
+// testKey is the first anvil and hardhat development key, owner 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266.
+const testKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+var (
+	testUSDC   = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	testRouter = common.HexToAddress("0xa195608C2306A26f727d5199D5A382a4508308DA")
+)
+
+// usedBitmap answers nonceBitmap with the first seven nonces of word 0 used, so Next returns 7.
+type usedBitmap struct{}
+
+func (usedBitmap) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (usedBitmap) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return common.LeftPadBytes([]byte{0x7f}, 32), nil
+}
+
+func testBuilder(t *testing.T) *Builder {
+	t.Helper()
+	key, err := crypto.HexToECDSA(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := NewKeySigner(key)
+	nonces, err := NewNonces(CanonicalAddress, signer.Address(), usedBitmap{}, new(big.Int))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewBuilder(1, CanonicalAddress, testRouter, signer, nonces)
+}
+
+func word(v []byte) []byte {
+	return common.LeftPadBytes(v, 32)
+}
+
+func TestSignKnownVector(t *testing.T) {
+	amount, deadline := big.NewInt(1_000_000_000), big.NewInt(1_700_000_000)
+	permit := PermitTransferFrom{Token: testUSDC, Amount: amount, Spender: testRouter, Nonce: big.NewInt(7), Deadline: deadline}
+
+	// The digest of BaseSetup._getPermitEIP712Hash, encoded by hand from the published constants.
+	tokenPermissions := crypto.Keccak256(tokenPermissionsTypehash[:], word(testUSDC[:]), word(amount.Bytes()))
+	structHash := crypto.Keccak256(permitTransferFromTypehash[:], tokenPermissions, word(testRouter[:]), word([]byte{7}), word(deadline.Bytes()))
+	want := crypto.Keccak256Hash([]byte("\x19\x01"), mainnetDomainSeparator[:], structHash)
+	if want != common.HexToHash("0x9d93630e6075dcd1ca4aa03800806df38528b961ef3b124c5894354212f925d3") {
+		t.Fatalf("hand encoded digest %s", want)
+	}
+	digest, err := permit.Hash(1, CanonicalAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest != want {
+		t.Fatalf("digest %s, want %s", digest, want)
+	}
+
+	data, err := testBuilder(t).Sign(context.Background(), testUSDC, amount, deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, gotDeadline, sig, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce.Cmp(big.NewInt(7)) != 0 || gotDeadline.Cmp(deadline) != 0 {
+		t.Fatalf("nonce %s deadline %s", nonce, gotDeadline)
+	}
+	wantSig := hexutil.MustDecode("0x513defee5a43f02b70218a7786f867778ad5c4995d52bb76a4908f98f3f0118134c0715924e74eeff627579502b2a1cff9de3ff133fa7c03d61a75a42dd0d6571c")
+	if !bytes.Equal(sig, wantSig) {
+		t.Fatalf("signature %x, want %x", sig, wantSig)
+	}
+	recoverable := append([]byte{}, sig...)
+	recoverable[crypto.RecoveryIDOffset] -= 27
+	pub, err := crypto.SigToPub(want[:], recoverable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner := crypto.PubkeyToAddress(*pub); owner != common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266") {
+		t.Fatalf("signature recovers to %s", owner)
+	}
+}
+
+func TestSignMultiVault(t *testing.T) {
+	liq := contracts.LiqRequest{Token: testUSDC}
+	tests := []struct {
+		name      string
+		sf        contracts.MultiVaultSFData
+		amountsIn []*big.Int
+		wantErr   error
+	}{
+		{name: "amounts", sf: contracts.MultiVaultSFData{Amounts: []*big.Int{big.NewInt(1), big.NewInt(2)}, LiqRequests: []contracts.LiqRequest{liq, liq}}},
+		{name: "amounts in", sf: contracts.MultiVaultSFData{LiqRequests: []contracts.LiqRequest{liq, liq}}, amountsIn: []*big.Int{big.NewInt(1), big.NewInt(2)}},
+		{name: "short amounts in", sf: contracts.MultiVaultSFData{LiqRequests: []contracts.LiqRequest{liq, liq}}, amountsIn: []*big.Int{big.NewInt(3)}, wantErr: ErrAmountsLength},
+		{name: "long amounts", sf: contracts.MultiVaultSFData{Amounts: []*big.Int{big.NewInt(1), big.NewInt(2)}, LiqRequests: []contracts.LiqRequest{liq}}, wantErr: ErrAmountsLength},
+		{name: "mixed tokens", sf: contracts.MultiVaultSFData{LiqRequests: []contracts.LiqRequest{liq, {Token: testRouter}}}, amountsIn: []*big.Int{big.NewInt(1), big.NewInt(2)}, wantErr: ErrMixedTokens},
+		{name: "txData without amounts in", sf: contracts.MultiVaultSFData{Amounts: []*big.Int{big.NewInt(1)}, LiqRequests: []contracts.LiqRequest{{Token: testUSDC, TxData: []byte{0x01}}}}, wantErr: ErrTxDataAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf := tt.sf
+			err := testBuilder(t).SignMultiVault(context.Background(), &sf, tt.amountsIn, big.NewInt(1_700_000_000))
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if sf.Permit2data != nil {
+					t.Fatal("permit2data set on error")
+				}
+				return
+			}
+			// The permit covers the total of 3 the router pulls.
+			_, _, sig, err := Decode(sf.Permit2data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			digest, err := PermitTransferFrom{Token: testUSDC, Amount: big.NewInt(3), Spender: testRouter, Nonce: big.NewInt(7), Deadline: big.NewInt(1_700_000_000)}.Hash(1, CanonicalAddress)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig[crypto.RecoveryIDOffset] -= 27
+			pub, err := crypto.SigToPub(digest[:], sig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if crypto.PubkeyToAddress(*pub) != common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266") {
+				t.Fatal("permit does not cover the total")
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package permit2
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer produces 65 byte r||s||v signatures over EIP-712 digests. Implementations can wrap a local key, a
+// keystore, a remote signer or a hardware wallet.
+type Signer interface {
+	// Address is the token owner the signatures recover to.
+	Address() common.Address
+	// SignHash signs the digest, returning a signature with v in {27, 28}.
+	SignHash(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+// KeySigner is a Signer backed by an in-memory private key.
+type KeySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewKeySigner creates a KeySigner for key.
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{key: key}
+}
+
+// Address implements Signer.
+func (s *KeySigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+// SignHash implements Signer.
+func (s *KeySigner) SignHash(_ context.Context, hash common.Hash) ([]byte, error) {
+	sig, err := crypto.Sign(hash.Bytes(), s.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
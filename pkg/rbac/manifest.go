@@ -0,0 +1,155 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrLastAdmin is returned when a manifest would leave PROTOCOL_ADMIN_ROLE or EMERGENCY_ADMIN_ROLE without
+// members, which SuperRBAC refuses with CANNOT_REVOKE_LAST_ADMIN.
+var ErrLastAdmin = errors.New("rbac: manifest revokes the last admin")
+
+// Manifest is the desired role membership, read from YAML:
+//
+//	defaults:
+//	  PROTOCOL_ADMIN_ROLE: [0x...]
+//	  CORE_STATE_REGISTRY_PROCESSOR_ROLE: [0x...]
+//	chains:
+//	  8453:
+//	    CORE_STATE_REGISTRY_PROCESSOR_ROLE: [0x...]
+//
+// Roles are keyed by known name or 0x prefixed 32 byte hex id. A chain entry replaces the default members of that role on the chain.
+// Only listed roles are managed: their members are exactly the listed accounts, and an empty list revokes every
+// member. Roles missing from the manifest are left alone.
+type Manifest struct {
+	Defaults map[Role][]common.Address            `yaml:"defaults"`
+	Chains   map[uint64]map[Role][]common.Address `yaml:"chains"`
+}
+
+// LoadManifest reads a YAML manifest from file.
+func LoadManifest(file string) (Manifest, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return Manifest{}, err
+	}
+	m, err := ParseManifest(raw)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("%w: %s", err, file)
+	}
+	return m, nil
+}
+
+// ParseManifest decodes a YAML manifest.
+func ParseManifest(data []byte) (Manifest, error) {
+	var m Manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return Manifest{}, fmt.Errorf("rbac: manifest: %w", err)
+	}
+	return m, nil
+}
+
+// Desired returns the managed roles of chainID and their members.
+func (m Manifest) Desired(chainID uint64) map[Role]map[common.Address]bool {
+	out := make(map[Role]map[common.Address]bool)
+	set := func(roles map[Role][]common.Address) {
+		for r, accounts := range roles {
+			members := make(map[common.Address]bool, len(accounts))
+			for _, a := range accounts {
+				members[a] = true
+			}
+			out[r] = members
+		}
+	}
+	set(m.Defaults)
+	set(m.Chains[chainID])
+	return out
+}
+
+// Call is a grantRole or revokeRole call to send to a chain's SuperRBAC.
+type Call struct {
+	ChainID uint64
+	RBAC    common.Address
+	// Method is "grantRole" or "revokeRole".
+	Method  string
+	Role    Role
+	Account common.Address
+	// Data is the calldata, e.g. for a multisig transaction builder.
+	Data []byte
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("chain %d: %s.%s(%s, %s)", c.ChainID, c.RBAC, c.Method, c.Role, c.Account)
+}
+
+// Plan returns the calls converging state to the manifest. Grants come before revokes, in role then account order,
+// so that admin roles are handed over before being taken away, and PROTOCOL_ADMIN_ROLE revokes always come last:
+// the calls must be sent by a holder of the role's admin role, PROTOCOL_ADMIN_ROLE for every role SuperRBAC sets
+// up, and revoking the sender's admin role first would make the remaining calls revert.
+func (m Manifest) Plan(state State) ([]Call, error) {
+	parsed, err := contracts.SuperRBACMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	desired := m.Desired(state.ChainID)
+	roles := make([]Role, 0, len(desired))
+	for r := range desired {
+		roles = append(roles, r)
+	}
+	sortRoles(roles)
+
+	var grants, revokes, adminRevokes []Call
+	call := func(method string, r Role, a common.Address) (Call, error) {
+		data, err := parsed.Pack(method, r, a)
+		if err != nil {
+			return Call{}, err
+		}
+		return Call{ChainID: state.ChainID, RBAC: state.RBAC, Method: method, Role: r, Account: a, Data: data}, nil
+	}
+	for _, r := range roles {
+		if (r == ProtocolAdmin || r == EmergencyAdmin) && len(desired[r]) == 0 {
+			return nil, fmt.Errorf("%w: %s on chain %d", ErrLastAdmin, r, state.ChainID)
+		}
+		for _, a := range sortedAccounts(desired[r]) {
+			if state.Has(r, a) {
+				continue
+			}
+			c, err := call("grantRole", r, a)
+			if err != nil {
+				return nil, err
+			}
+			grants = append(grants, c)
+		}
+		for _, a := range state.Accounts(r) {
+			if desired[r][a] {
+				continue
+			}
+			c, err := call("revokeRole", r, a)
+			if err != nil {
+				return nil, err
+			}
+			if r == ProtocolAdmin {
+				adminRevokes = append(adminRevokes, c)
+			} else {
+				revokes = append(revokes, c)
+			}
+		}
+	}
+	return append(append(grants, revokes...), adminRevokes...), nil
+}
+
+// Format renders calls one per line.
+func Format(calls []Call) string {
+	var b strings.Builder
+	for _, c := range calls {
+		b.WriteString(c.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
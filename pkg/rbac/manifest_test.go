@@ -0,0 +1,167 @@
+package rbac
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	alice = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob   = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	carol = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+const testManifest = `
+defaults:
+  PROTOCOL_ADMIN_ROLE: [0x1111111111111111111111111111111111111111]
+  CORE_STATE_REGISTRY_PROCESSOR_ROLE: [0x2222222222222222222222222222222222222222]
+  "0x00000000000000000000000000000000000000000000000000000000000000aa": []
+chains:
+  8453:
+    CORE_STATE_REGISTRY_PROCESSOR_ROLE:
+      - 0x2222222222222222222222222222222222222222
+      - 0x3333333333333333333333333333333333333333
+`
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest([]byte(testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := Role(common.HexToHash("0xaa"))
+
+	tests := []struct {
+		chainID uint64
+		role    Role
+		want    []common.Address
+	}{
+		{10, ProtocolAdmin, []common.Address{alice}},
+		{10, CoreStateRegistryProcessor, []common.Address{bob}},
+		{10, custom, nil},
+		{8453, ProtocolAdmin, []common.Address{alice}},
+		// a chain entry replaces the default members
+		{8453, CoreStateRegistryProcessor, []common.Address{bob, carol}},
+	}
+	for _, tt := range tests {
+		desired := m.Desired(tt.chainID)
+		members, ok := desired[tt.role]
+		if !ok {
+			t.Fatalf("chain %d: %s not managed", tt.chainID, tt.role)
+		}
+		if got := sortedAccounts(members); len(got) != len(tt.want) {
+			t.Fatalf("chain %d: %s members %v, want %v", tt.chainID, tt.role, got, tt.want)
+		}
+		for _, a := range tt.want {
+			if !members[a] {
+				t.Fatalf("chain %d: %s misses %s", tt.chainID, tt.role, a)
+			}
+		}
+	}
+	if len(m.Desired(10)) != 3 {
+		t.Fatalf("managed roles %v", m.Desired(10))
+	}
+}
+
+func TestParseManifestRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr error
+	}{
+		{"misspelt role", "defaults:\n  PROTOCOL_ADMIN: [0x1111111111111111111111111111111111111111]\n", ErrUnknownRole},
+		{"short role id", "defaults:\n  \"0xaa\": []\n", nil},
+		{"bad yaml", "defaults: [", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseManifest([]byte(tt.yaml))
+			if err == nil {
+				t.Fatal("manifest parsed")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func state(members map[Role][]common.Address) State {
+	s := State{ChainID: 10, RBAC: carol, Members: make(map[Role]map[common.Address]bool)}
+	for r, accounts := range members {
+		s.Members[r] = make(map[common.Address]bool)
+		for _, a := range accounts {
+			s.Members[r][a] = true
+		}
+	}
+	return s
+}
+
+func TestPlan(t *testing.T) {
+	parsed, err := contracts.SuperRBACMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := Manifest{Defaults: map[Role][]common.Address{
+		ProtocolAdmin:              {bob},
+		CoreStateRegistryProcessor: {alice},
+		DstSwapper:                 {},
+	}}
+	granted := state(map[Role][]common.Address{
+		ProtocolAdmin:              {alice},
+		CoreStateRegistryProcessor: {alice, carol},
+		DstSwapper:                 {bob},
+		// roles missing from the manifest are left alone
+		EmergencyAdmin: {alice},
+	})
+
+	calls, err := m.Plan(granted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		method  string
+		role    Role
+		account common.Address
+	}{
+		{"grantRole", ProtocolAdmin, bob},
+		{"revokeRole", CoreStateRegistryProcessor, carol},
+		{"revokeRole", DstSwapper, bob},
+		{"revokeRole", ProtocolAdmin, alice},
+	}
+	// revokes are ordered by role id
+	if common.Hash(DstSwapper).Cmp(common.Hash(CoreStateRegistryProcessor)) < 0 {
+		want[1], want[2] = want[2], want[1]
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls:\n%s", Format(calls))
+	}
+	for i, w := range want {
+		c := calls[i]
+		if c.Method != w.method || c.Role != w.role || c.Account != w.account || c.ChainID != 10 || c.RBAC != carol {
+			t.Fatalf("call %d is %s, want %s(%s, %s)", i, c, w.method, w.role, w.account)
+		}
+		data, err := parsed.Pack(w.method, w.role, w.account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(c.Data) != string(data) {
+			t.Fatalf("call %d data %x", i, c.Data)
+		}
+	}
+
+	converged := state(map[Role][]common.Address{ProtocolAdmin: {bob}, CoreStateRegistryProcessor: {alice}})
+	if calls, err := m.Plan(converged); err != nil || len(calls) != 0 {
+		t.Fatalf("converged state planned %v, %v", calls, err)
+	}
+
+	for _, admin := range []Role{ProtocolAdmin, EmergencyAdmin} {
+		m := Manifest{Defaults: map[Role][]common.Address{admin: {}}}
+		if _, err := m.Plan(granted); !errors.Is(err, ErrLastAdmin) {
+			t.Fatalf("%s: err %v, want %v", admin, err, ErrLastAdmin)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+// Package rbac audits SuperRBAC role membership across chains.
+//
+// SuperRBAC is an OpenZeppelin AccessControlEnumerable: every grant and revoke emits RoleGranted or RoleRevoked,
+// including the grants of its constructor and the revokes replayed from a revokeRoleSuperBroadcast. Replaying those
+// events rebuilds who holds which role; the result is checked against getRoleMemberCount, then compared between
+// chains and with a desired-state manifest to plan the grantRole and revokeRole calls that converge them.
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrUnknownRole is returned when decoding a role name missing from the known roles; custom roles are given by id.
+var ErrUnknownRole = errors.New("rbac: unknown role name")
+
+// Role is a SuperRBAC role id, e.g. keccak256("PROTOCOL_ADMIN_ROLE").
+type Role [32]byte
+
+// NewRole returns the id of the role called name. DEFAULT_ADMIN_ROLE is the zero id.
+func NewRole(name string) Role {
+	if name == "DEFAULT_ADMIN_ROLE" {
+		return Role{}
+	}
+	return Role(crypto.Keccak256Hash([]byte(name)))
+}
+
+// String returns the role name for known ids and the hex id otherwise.
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return common.Hash(r).Hex()
+}
+
+// MarshalText encodes the role as its name when known and its hex id otherwise.
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText decodes a known role name or a 0x prefixed 32 byte hex id. Roles missing from the known table must
+// be given by id, so that a misspelt name is not silently hashed into a role nobody holds.
+func (r *Role) UnmarshalText(text []byte) error {
+	if strings.HasPrefix(string(text), "0x") || strings.HasPrefix(string(text), "0X") {
+		b, err := hexutil.Decode(string(text))
+		if err != nil {
+			return fmt.Errorf("rbac: role id %s: %w", text, err)
+		}
+		if len(b) != common.HashLength {
+			return fmt.Errorf("rbac: role id %s is not 32 bytes", text)
+		}
+		*r = Role(common.BytesToHash(b))
+		return nil
+	}
+	role := NewRole(string(text))
+	if roleNames[role] != string(text) {
+		return fmt.Errorf("%w: %s", ErrUnknownRole, text)
+	}
+	*r = role
+	return nil
+}
+
+// Roles granted by SuperRBAC and the contracts checking it.
+var (
+	DefaultAdmin                    = NewRole("DEFAULT_ADMIN_ROLE")
+	ProtocolAdmin                   = NewRole("PROTOCOL_ADMIN_ROLE")
+	EmergencyAdmin                  = NewRole("EMERGENCY_ADMIN_ROLE")
+	PaymentAdmin                    = NewRole("PAYMENT_ADMIN_ROLE")
+	Broadcaster                     = NewRole("BROADCASTER_ROLE")
+	CoreStateRegistryProcessor      = NewRole("CORE_STATE_REGISTRY_PROCESSOR_ROLE")
+	TimelockStateRegistryProcessor  = NewRole("TIMELOCK_STATE_REGISTRY_PROCESSOR_ROLE")
+	BroadcastStateRegistryProcessor = NewRole("BROADCAST_STATE_REGISTRY_PROCESSOR_ROLE")
+	CoreStateRegistryUpdater        = NewRole("CORE_STATE_REGISTRY_UPDATER_ROLE")
+	CoreStateRegistryRescuer        = NewRole("CORE_STATE_REGISTRY_RESCUER_ROLE")
+	CoreStateRegistryDisputer       = NewRole("CORE_STATE_REGISTRY_DISPUTER_ROLE")
+	DstSwapper                      = NewRole("DST_SWAPPER_ROLE")
+	WormholeVAARelayer              = NewRole("WORMHOLE_VAA_RELAYER_ROLE")
+	AsyncStateRegistryProcessor     = NewRole("ASYNC_STATE_REGISTRY_PROCESSOR_ROLE")
+	RouterPlusProcessor             = NewRole("ROUTER_PLUS_PROCESSOR_ROLE")
+	RewardsAdmin                    = NewRole("REWARDS_ADMIN_ROLE")
+)
+
+var roleNames = map[Role]string{
+	DefaultAdmin:                    "DEFAULT_ADMIN_ROLE",
+	ProtocolAdmin:                   "PROTOCOL_ADMIN_ROLE",
+	EmergencyAdmin:                  "EMERGENCY_ADMIN_ROLE",
+	PaymentAdmin:                    "PAYMENT_ADMIN_ROLE",
+	Broadcaster:                     "BROADCASTER_ROLE",
+	CoreStateRegistryProcessor:      "CORE_STATE_REGISTRY_PROCESSOR_ROLE",
+	TimelockStateRegistryProcessor:  "TIMELOCK_STATE_REGISTRY_PROCESSOR_ROLE",
+	BroadcastStateRegistryProcessor: "BROADCAST_STATE_REGISTRY_PROCESSOR_ROLE",
+	CoreStateRegistryUpdater:        "CORE_STATE_REGISTRY_UPDATER_ROLE",
+	CoreStateRegistryRescuer:        "CORE_STATE_REGISTRY_RESCUER_ROLE",
+	CoreStateRegistryDisputer:       "CORE_STATE_REGISTRY_DISPUTER_ROLE",
+	DstSwapper:                      "DST_SWAPPER_ROLE",
+	WormholeVAARelayer:              "WORMHOLE_VAA_RELAYER_ROLE",
+	AsyncStateRegistryProcessor:     "ASYNC_STATE_REGISTRY_PROCESSOR_ROLE",
+	RouterPlusProcessor:             "ROUTER_PLUS_PROCESSOR_ROLE",
+	RewardsAdmin:                    "REWARDS_ADMIN_ROLE",
+}
+
+// State is the role membership of one chain's SuperRBAC.
+type State struct {
+	ChainID uint64
+	RBAC    common.Address
+	// Block is the last block the events were replayed up to.
+	Block   uint64
+	Members map[Role]map[common.Address]bool
+}
+
+// Has reports whether account holds role.
+func (s State) Has(role Role, account common.Address) bool {
+	return s.Members[role][account]
+}
+
+// Roles returns the roles with at least one member, in id order.
+func (s State) Roles() []Role {
+	out := make([]Role, 0, len(s.Members))
+	for r, m := range s.Members {
+		if len(m) > 0 {
+			out = append(out, r)
+		}
+	}
+	sortRoles(out)
+	return out
+}
+
+// Accounts returns the members of role in address order.
+func (s State) Accounts(role Role) []common.Address {
+	return sortedAccounts(s.Members[role])
+}
+
+// DefaultBlockRange is the number of blocks the auditor queries logs for at once unless the Chain sets another.
+const DefaultBlockRange = 5000
+
+// Chain is the access the auditor needs to one chain.
+type Chain struct {
+	Backend   bind.ContractBackend
+	SuperRBAC common.Address
+	// FromBlock is where RoleGranted and RoleRevoked are replayed from, at latest SuperRBAC's deployment block.
+	FromBlock uint64
+	// BlockRange is the number of blocks queried for logs at once; zero uses DefaultBlockRange.
+	BlockRange uint64
+}
+
+type chainState struct {
+	Chain
+	rbac  *contracts.SuperRBAC
+	state State
+	next  uint64
+}
+
+// Auditor rebuilds and compares SuperRBAC role membership across chains.
+type Auditor struct {
+	mu     sync.Mutex
+	chains map[uint64]*chainState
+}
+
+// NewAuditor creates an Auditor over chains keyed by chain id.
+func NewAuditor(chains map[uint64]Chain) (*Auditor, error) {
+	a := &Auditor{chains: make(map[uint64]*chainState, len(chains))}
+	for id, c := range chains {
+		rbac, err := contracts.NewSuperRBAC(c.SuperRBAC, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		if c.BlockRange == 0 {
+			c.BlockRange = DefaultBlockRange
+		}
+		a.chains[id] = &chainState{
+			Chain: c,
+			rbac:  rbac,
+			state: State{ChainID: id, RBAC: c.SuperRBAC, Members: make(map[Role]map[common.Address]bool)},
+			next:  c.FromBlock,
+		}
+	}
+	return a, nil
+}
+
+// ChainIDs returns the audited chains in ascending order.
+func (a *Auditor) ChainIDs() []uint64 {
+	ids := make([]uint64, 0, len(a.chains))
+	for id := range a.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// State replays the RoleGranted and RoleRevoked events of chainID up to its head, continuing from the previous
+// call, and returns a copy of the membership.
+func (a *Auditor) State(ctx context.Context, chainID uint64) (State, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	c, ok := a.chains[chainID]
+	if !ok {
+		return State{}, fmt.Errorf("rbac: unknown chain %d", chainID)
+	}
+	if err := c.sync(ctx); err != nil {
+		return State{}, fmt.Errorf("rbac: chain %d: %w", chainID, err)
+	}
+	return c.state.clone(), nil
+}
+
+// States returns the State of every chain in chain id order.
+func (a *Auditor) States(ctx context.Context) ([]State, error) {
+	var out []State
+	for _, id := range a.ChainIDs() {
+		s, err := a.State(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// roleEvent is a RoleGranted or RoleRevoked log.
+type roleEvent struct {
+	role    Role
+	account common.Address
+	granted bool
+	raw     types.Log
+}
+
+// sync replays the events from the next unreplayed block to the head, BlockRange blocks at a time, then checks the
+// member count of every role it changed.
+func (c *chainState) sync(ctx context.Context) error {
+	head, err := c.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	to := head.Number.Uint64()
+	touched := make(map[Role]bool)
+	for c.next <= to {
+		end := min(c.next+c.BlockRange-1, to)
+		if err := c.replay(ctx, c.next, end, touched); err != nil {
+			return fmt.Errorf("blocks %d-%d: %w", c.next, end, err)
+		}
+		c.state.Block, c.next = end, end+1
+	}
+
+	// AccessControlEnumerable keeps the member count; a difference means events were missed, usually because
+	// FromBlock is after the deployment.
+	call := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	for role := range touched {
+		count, err := c.rbac.GetRoleMemberCount(call, role)
+		if err != nil {
+			return err
+		}
+		if !count.IsInt64() || count.Int64() != int64(len(c.state.Members[role])) {
+			log.Warn("SuperRBAC members do not match replayed events", "chainId", c.state.ChainID, "role", role, "onchain", count, "replayed", len(c.state.Members[role]))
+		}
+	}
+	return nil
+}
+
+// replay applies the RoleGranted and RoleRevoked events of blocks start to end in log order, adding the roles they
+// change to touched.
+func (c *chainState) replay(ctx context.Context, start, end uint64, touched map[Role]bool) error {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+
+	var events []roleEvent
+	granted, err := c.rbac.FilterRoleGranted(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer granted.Close()
+	for granted.Next() {
+		events = append(events, roleEvent{role: granted.Event.Role, account: granted.Event.Account, granted: true, raw: granted.Event.Raw})
+	}
+	if err := granted.Error(); err != nil {
+		return err
+	}
+	revoked, err := c.rbac.FilterRoleRevoked(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer revoked.Close()
+	for revoked.Next() {
+		events = append(events, roleEvent{role: revoked.Event.Role, account: revoked.Event.Account, raw: revoked.Event.Raw})
+	}
+	if err := revoked.Error(); err != nil {
+		return err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].raw.BlockNumber != events[j].raw.BlockNumber {
+			return events[i].raw.BlockNumber < events[j].raw.BlockNumber
+		}
+		return events[i].raw.Index < events[j].raw.Index
+	})
+	for _, e := range events {
+		if e.raw.Removed {
+			continue
+		}
+		members := c.state.Members[e.role]
+		if members == nil {
+			members = make(map[common.Address]bool)
+			c.state.Members[e.role] = members
+		}
+		if e.granted {
+			members[e.account] = true
+		} else {
+			delete(members, e.account)
+		}
+		touched[e.role] = true
+	}
+	return nil
+}
+
+func (s State) clone() State {
+	out := s
+	out.Members = make(map[Role]map[common.Address]bool, len(s.Members))
+	for r, m := range s.Members {
+		cp := make(map[common.Address]bool, len(m))
+		for a := range m {
+			cp[a] = true
+		}
+		out.Members[r] = cp
+	}
+	return out
+}
+
+// Drift is a role member present on some chains and missing on others.
+type Drift struct {
+	Role    Role
+	Account common.Address
+	Present []uint64
+	Missing []uint64
+}
+
+// Compare diffs the membership of states against each other, in role then account order. Only roles held on at
+// least one chain are compared, and accounts holding a role everywhere are left out.
+func Compare(states []State) []Drift {
+	holders := make(map[Role]map[common.Address]bool)
+	for _, s := range states {
+		for r, m := range s.Members {
+			for a := range m {
+				if holders[r] == nil {
+					holders[r] = make(map[common.Address]bool)
+				}
+				holders[r][a] = true
+			}
+		}
+	}
+	roles := make([]Role, 0, len(holders))
+	for r := range holders {
+		roles = append(roles, r)
+	}
+	sortRoles(roles)
+
+	var out []Drift
+	for _, r := range roles {
+		for _, a := range sortedAccounts(holders[r]) {
+			d := Drift{Role: r, Account: a}
+			for _, s := range states {
+				if s.Has(r, a) {
+					d.Present = append(d.Present, s.ChainID)
+				} else {
+					d.Missing = append(d.Missing, s.ChainID)
+				}
+			}
+			if len(d.Missing) > 0 {
+				out = append(out, d)
+			}
+		}
+	}
+	return out
+}
+
+func sortRoles(roles []Role) {
+	sort.Slice(roles, func(i, j int) bool { return common.Hash(roles[i]).Cmp(common.Hash(roles[j])) < 0 })
+}
+
+func sortedAccounts(set map[common.Address]bool) []common.Address {
+	out := make([]common.Address, 0, len(set))
+	for a := range set {
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Cmp(out[j]) < 0 })
+	return out
+}
@@ -0,0 +1,441 @@
+// Package registry resolves SuperRegistry entries and verifies them against the deployment address books.
+//
+// SuperRegistry maps keccak256 ids to the protocol modules and keeper roles of every chain (getAddressByChainId),
+// and small integer ids to liquidity bridges and their validators, AMB implementations and state registries. Ids
+// known from the deployment scripts are always resolved; Discover adds any other id the registry logged being set.
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrUnset is returned when nothing is registered under an id; the registry getters revert with ZERO_ADDRESS.
+var ErrUnset = errors.New("registry: id not set")
+
+// zeroAddress is the selector of Error.ZERO_ADDRESS.
+var zeroAddress = crypto.Keccak256([]byte("ZERO_ADDRESS()"))[:4]
+
+// unset reports whether err is the ZERO_ADDRESS revert of a registry getter.
+func unset(err error) bool {
+	var de rpc.DataError
+	if !errors.As(err, &de) {
+		return false
+	}
+	data, ok := de.ErrorData().(string)
+	if !ok {
+		return false
+	}
+	raw, err := hexutil.Decode(data)
+	return err == nil && bytes.HasPrefix(raw, zeroAddress)
+}
+
+// ID is a SuperRegistry address id, e.g. keccak256("SUPERFORM_ROUTER").
+type ID [32]byte
+
+// NewID returns the id SuperRegistry registers name under.
+func NewID(name string) ID {
+	return ID(crypto.Keccak256Hash([]byte(name)))
+}
+
+// String returns the registry name for known ids and the hex id otherwise.
+func (id ID) String() string {
+	if name, ok := idNames[id]; ok {
+		return name
+	}
+	return common.Hash(id).Hex()
+}
+
+// Module ids.
+var (
+	SuperformRouter       = NewID("SUPERFORM_ROUTER")
+	SuperformFactory      = NewID("SUPERFORM_FACTORY")
+	PayMaster             = NewID("PAYMASTER")
+	PaymentHelper         = NewID("PAYMENT_HELPER")
+	CoreStateRegistry     = NewID("CORE_STATE_REGISTRY")
+	TimelockStateRegistry = NewID("TIMELOCK_STATE_REGISTRY")
+	BroadcastRegistry     = NewID("BROADCAST_REGISTRY")
+	AsyncStateRegistry    = NewID("ASYNC_STATE_REGISTRY")
+	SuperPositions        = NewID("SUPER_POSITIONS")
+	SuperRBAC             = NewID("SUPER_RBAC")
+	PayloadHelper         = NewID("PAYLOAD_HELPER")
+	DstSwapper            = NewID("DST_SWAPPER")
+	EmergencyQueue        = NewID("EMERGENCY_QUEUE")
+	SuperformRouterPlus   = NewID("SUPERFORM_ROUTER_PLUS")
+	RewardsDistributor    = NewID("REWARDS_DISTRIBUTOR")
+)
+
+// Role ids, registered with the keeper or admin address holding them.
+var (
+	SuperformReceiver            = NewID("SUPERFORM_RECEIVER")
+	PaymentAdmin                 = NewID("PAYMENT_ADMIN")
+	CoreRegistryProcessor        = NewID("CORE_REGISTRY_PROCESSOR")
+	BroadcastRegistryProcessor   = NewID("BROADCAST_REGISTRY_PROCESSOR")
+	TimelockRegistryProcessor    = NewID("TIMELOCK_REGISTRY_PROCESSOR")
+	CoreRegistryUpdater          = NewID("CORE_REGISTRY_UPDATER")
+	CoreRegistryRescuer          = NewID("CORE_REGISTRY_RESCUER")
+	CoreRegistryDisputer         = NewID("CORE_REGISTRY_DISPUTER")
+	DstSwapperProcessor          = NewID("DST_SWAPPER_PROCESSOR")
+	CoreStateRegistryRescuerRole = NewID("CORE_STATE_REGISTRY_RESCUER_ROLE")
+)
+
+// Module ties a module id to the contract name the address books record it under.
+type Module struct {
+	ID       ID
+	Contract string
+}
+
+// Modules are the module ids set by the deployment scripts.
+var Modules = []Module{
+	{SuperformRouter, "SuperformRouter"},
+	{SuperformFactory, "SuperformFactory"},
+	{PayMaster, "PayMaster"},
+	{PaymentHelper, "PaymentHelper"},
+	{CoreStateRegistry, "CoreStateRegistry"},
+	{TimelockStateRegistry, "TimelockStateRegistry"},
+	{BroadcastRegistry, "BroadcastRegistry"},
+	{AsyncStateRegistry, "AsyncStateRegistry"},
+	{SuperPositions, "SuperPositions"},
+	{SuperRBAC, "SuperRBAC"},
+	{PayloadHelper, "PayloadHelper"},
+	{DstSwapper, "DstSwapper"},
+	{EmergencyQueue, "EmergencyQueue"},
+	{SuperformRouterPlus, "SuperformRouterPlus"},
+	{RewardsDistributor, "RewardsDistributor"},
+}
+
+// Roles are the role ids set by the deployment scripts.
+var Roles = []ID{
+	SuperformReceiver,
+	PaymentAdmin,
+	CoreRegistryProcessor,
+	BroadcastRegistryProcessor,
+	TimelockRegistryProcessor,
+	CoreRegistryUpdater,
+	CoreRegistryRescuer,
+	CoreRegistryDisputer,
+	DstSwapperProcessor,
+	CoreStateRegistryRescuerRole,
+}
+
+var idNames = map[ID]string{
+	SuperformRouter:              "SUPERFORM_ROUTER",
+	SuperformFactory:             "SUPERFORM_FACTORY",
+	PayMaster:                    "PAYMASTER",
+	PaymentHelper:                "PAYMENT_HELPER",
+	CoreStateRegistry:            "CORE_STATE_REGISTRY",
+	TimelockStateRegistry:        "TIMELOCK_STATE_REGISTRY",
+	BroadcastRegistry:            "BROADCAST_REGISTRY",
+	AsyncStateRegistry:           "ASYNC_STATE_REGISTRY",
+	SuperPositions:               "SUPER_POSITIONS",
+	SuperRBAC:                    "SUPER_RBAC",
+	PayloadHelper:                "PAYLOAD_HELPER",
+	DstSwapper:                   "DST_SWAPPER",
+	EmergencyQueue:               "EMERGENCY_QUEUE",
+	SuperformRouterPlus:          "SUPERFORM_ROUTER_PLUS",
+	RewardsDistributor:           "REWARDS_DISTRIBUTOR",
+	SuperformReceiver:            "SUPERFORM_RECEIVER",
+	PaymentAdmin:                 "PAYMENT_ADMIN",
+	CoreRegistryProcessor:        "CORE_REGISTRY_PROCESSOR",
+	BroadcastRegistryProcessor:   "BROADCAST_REGISTRY_PROCESSOR",
+	TimelockRegistryProcessor:    "TIMELOCK_REGISTRY_PROCESSOR",
+	CoreRegistryUpdater:          "CORE_REGISTRY_UPDATER",
+	CoreRegistryRescuer:          "CORE_REGISTRY_RESCUER",
+	CoreRegistryDisputer:         "CORE_REGISTRY_DISPUTER",
+	DstSwapperProcessor:          "DST_SWAPPER_PROCESSOR",
+	CoreStateRegistryRescuerRole: "CORE_STATE_REGISTRY_RESCUER_ROLE",
+}
+
+// BridgeValidators maps the liquidity bridge ids of the deployment scripts to the address book name of their
+// validator. The bridge addresses themselves are external contracts.
+var BridgeValidators = map[uint8]string{
+	2:   "SocketValidator",
+	3:   "SocketOneInchValidator",
+	4:   "OneInchValidator",
+	5:   "DeBridgeValidator",
+	6:   "DeBridgeForwarderValidator",
+	101: "LiFiValidator",
+}
+
+// AMBImplementations maps the AMB ids of the deployment scripts to the address book name of their implementation.
+var AMBImplementations = map[uint8]string{
+	4: "WormholeSRImplementation",
+	5: "LayerzeroImplementation",
+	6: "HyperlaneImplementation",
+	7: "WormholeARImplementation",
+	8: "AxelarImplementation",
+	9: "LayerzeroV1Implementation",
+}
+
+// StateRegistries maps the state registry ids of the deployment scripts to their address book name.
+var StateRegistries = map[uint8]string{
+	1: "CoreStateRegistry",
+	2: "BroadcastRegistry",
+	4: "AsyncStateRegistry",
+}
+
+// Bridge is a liquidity bridge entry.
+type Bridge struct {
+	Address   common.Address
+	Validator common.Address
+}
+
+// AMB is an AMB implementation entry.
+type AMB struct {
+	Address   common.Address
+	Broadcast bool
+}
+
+// Snapshot is every resolved entry of one SuperRegistry. Unset entries are left out.
+type Snapshot struct {
+	ChainID uint64
+	Permit2 common.Address
+	// Addresses holds the module and role addresses registered for ChainID itself.
+	Addresses       map[ID]common.Address
+	Bridges         map[uint8]Bridge
+	AMBs            map[uint8]AMB
+	StateRegistries map[uint8]common.Address
+}
+
+// Client reads the SuperRegistry of one chain.
+type Client struct {
+	address  common.Address
+	registry *contracts.SuperRegistryCaller
+	filterer *contracts.SuperRegistryFilterer
+
+	mu         sync.Mutex
+	ids        map[ID]bool
+	bridgeIDs  map[uint8]bool
+	ambIDs     map[uint8]bool
+	registryID map[uint8]bool
+}
+
+// NewClient binds the SuperRegistry at address.
+func NewClient(backend bind.ContractBackend, address common.Address) (*Client, error) {
+	registry, err := contracts.NewSuperRegistryCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := contracts.NewSuperRegistryFilterer(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		address:    address,
+		registry:   registry,
+		filterer:   filterer,
+		ids:        make(map[ID]bool),
+		bridgeIDs:  make(map[uint8]bool),
+		ambIDs:     make(map[uint8]bool),
+		registryID: make(map[uint8]bool),
+	}
+	for _, m := range Modules {
+		c.ids[m.ID] = true
+	}
+	for _, id := range Roles {
+		c.ids[id] = true
+	}
+	for id := range BridgeValidators {
+		c.bridgeIDs[id] = true
+	}
+	for id := range AMBImplementations {
+		c.ambIDs[id] = true
+	}
+	for id := range StateRegistries {
+		c.registryID[id] = true
+	}
+	return c, nil
+}
+
+// Address returns the SuperRegistry address.
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// Caller exposes the binding for reads the client does not wrap.
+func (c *Client) Caller() *contracts.SuperRegistryCaller {
+	return c.registry
+}
+
+// Resolve returns the address registered under id for the registry's own chain, or ErrUnset.
+func (c *Client) Resolve(ctx context.Context, id ID) (common.Address, error) {
+	addr, err := c.registry.GetAddress(&bind.CallOpts{Context: ctx}, id)
+	if unset(err) {
+		return common.Address{}, fmt.Errorf("%w: %s", ErrUnset, id)
+	}
+	return addr, err
+}
+
+// ResolveOn returns the address registered under id for chainID, which is how the registry points at the
+// modules of remote chains, or ErrUnset.
+func (c *Client) ResolveOn(ctx context.Context, id ID, chainID uint64) (common.Address, error) {
+	addr, err := c.registry.GetAddressByChainId(&bind.CallOpts{Context: ctx}, id, chainID)
+	if unset(err) {
+		return common.Address{}, fmt.Errorf("%w: %s on %d", ErrUnset, id, chainID)
+	}
+	return addr, err
+}
+
+// Discover adds the ids the registry logged being set from fromBlock on, so that Snapshot also resolves ids the
+// deployment scripts do not know.
+func (c *Client) Discover(ctx context.Context, fromBlock uint64) error {
+	opts := &bind.FilterOpts{Start: fromBlock, Context: ctx}
+	chainID, err := c.registry.CHAINID(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	addrs, err := c.filterer.FilterAddressUpdated(opts, nil, []uint64{chainID}, nil)
+	if err != nil {
+		return err
+	}
+	defer addrs.Close()
+	for addrs.Next() {
+		c.ids[addrs.Event.ProtocolAddressId] = true
+	}
+	if err := addrs.Error(); err != nil {
+		return err
+	}
+
+	bridges, err := c.filterer.FilterSetBridgeAddress(opts, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer bridges.Close()
+	for bridges.Next() {
+		if bridges.Event.BridgeId.IsUint64() && bridges.Event.BridgeId.Uint64() <= 255 {
+			c.bridgeIDs[uint8(bridges.Event.BridgeId.Uint64())] = true
+		}
+	}
+	if err := bridges.Error(); err != nil {
+		return err
+	}
+
+	ambs, err := c.filterer.FilterSetAmbAddress(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer ambs.Close()
+	for ambs.Next() {
+		c.ambIDs[ambs.Event.AmbId] = true
+	}
+	if err := ambs.Error(); err != nil {
+		return err
+	}
+
+	registries, err := c.filterer.FilterSetStateRegistryAddress(opts, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer registries.Close()
+	for registries.Next() {
+		c.registryID[registries.Event.RegistryId] = true
+	}
+	return registries.Error()
+}
+
+// Snapshot resolves every known id. Bridge, AMB and state registry entries are read from the public mappings, which
+// unlike their getters do not revert when unset.
+func (c *Client) Snapshot(ctx context.Context) (Snapshot, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	c.mu.Lock()
+	ids, bridgeIDs, ambIDs, registryIDs := sortedIDs(c.ids), sortedUint8(c.bridgeIDs), sortedUint8(c.ambIDs), sortedUint8(c.registryID)
+	c.mu.Unlock()
+
+	var (
+		s   Snapshot
+		err error
+	)
+	if s.ChainID, err = c.registry.CHAINID(opts); err != nil {
+		return Snapshot{}, err
+	}
+	if s.Permit2, err = c.registry.PERMIT2(opts); err != nil && !unset(err) {
+		return Snapshot{}, err
+	}
+
+	s.Addresses = make(map[ID]common.Address)
+	for _, id := range ids {
+		addr, err := c.Resolve(ctx, id)
+		if errors.Is(err, ErrUnset) {
+			continue
+		}
+		if err != nil {
+			return Snapshot{}, fmt.Errorf("registry: %s: %w", id, err)
+		}
+		s.Addresses[id] = addr
+	}
+
+	s.Bridges = make(map[uint8]Bridge)
+	for _, id := range bridgeIDs {
+		var b Bridge
+		if b.Address, err = c.registry.BridgeAddresses(opts, id); err != nil {
+			return Snapshot{}, fmt.Errorf("registry: bridge %d: %w", id, err)
+		}
+		if b.Validator, err = c.registry.BridgeValidator(opts, id); err != nil {
+			return Snapshot{}, fmt.Errorf("registry: bridge %d: %w", id, err)
+		}
+		if b != (Bridge{}) {
+			s.Bridges[id] = b
+		}
+	}
+
+	s.AMBs = make(map[uint8]AMB)
+	for _, id := range ambIDs {
+		var a AMB
+		if a.Address, err = c.registry.AmbAddresses(opts, id); err != nil {
+			return Snapshot{}, fmt.Errorf("registry: amb %d: %w", id, err)
+		}
+		if a.Address == (common.Address{}) {
+			continue
+		}
+		if a.Broadcast, err = c.registry.IsBroadcastAMB(opts, id); err != nil {
+			return Snapshot{}, fmt.Errorf("registry: amb %d: %w", id, err)
+		}
+		s.AMBs[id] = a
+	}
+
+	s.StateRegistries = make(map[uint8]common.Address)
+	for _, id := range registryIDs {
+		addr, err := c.registry.RegistryAddresses(opts, id)
+		if err != nil {
+			return Snapshot{}, fmt.Errorf("registry: state registry %d: %w", id, err)
+		}
+		if addr != (common.Address{}) {
+			s.StateRegistries[id] = addr
+		}
+	}
+	return s, nil
+}
+
+func sortedIDs(set map[ID]bool) []ID {
+	out := make([]ID, 0, len(set))
+	for id := range set {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return common.Hash(out[i]).Cmp(common.Hash(out[j])) < 0 })
+	return out
+}
+
+func sortedUint8[V any](set map[uint8]V) []uint8 {
+	out := make([]uint8, 0, len(set))
+	for id := range set {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
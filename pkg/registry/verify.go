@@ -0,0 +1,259 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/deployments"
+)
+
+// Mismatch is a registry entry or contract pointer that disagrees with the address book.
+type Mismatch struct {
+	ChainID uint64
+	// Subject names what was checked, e.g. "SUPERFORM_ROUTER", "SUPERFORM_ROUTER on 10", "bridge validator 101" or
+	// "SuperformRouter.superRegistry()".
+	Subject string
+	// Expected is the address book address, Actual the one read on chain; zero means unset.
+	Expected common.Address
+	Actual   common.Address
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("chain %d: %s is %s, address book has %s", m.ChainID, m.Subject, m.Actual, m.Expected)
+}
+
+// Report is the verification of one chain.
+type Report struct {
+	ChainID    uint64
+	Chain      string
+	Registry   common.Address
+	Checked    int
+	Mismatches []Mismatch
+}
+
+// OK reports whether every check passed.
+func (r Report) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// superRegistryCaller is implemented by every binding of a contract holding a SuperRegistry pointer.
+type superRegistryCaller interface {
+	SuperRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// pointers binds the address book contracts whose SuperRegistry() pointer is verified.
+var pointers = map[string]func(common.Address, bind.ContractCaller) (superRegistryCaller, error){
+	"AsyncStateRegistry": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewAsyncStateRegistryCaller(a, b)
+	},
+	"AxelarImplementation": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewAxelarImplementationCaller(a, b)
+	},
+	"BroadcastRegistry": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewBroadcastRegistryCaller(a, b)
+	},
+	"CoreStateRegistry": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewCoreStateRegistryCaller(a, b)
+	},
+	"DstSwapper": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewDstSwapperCaller(a, b)
+	},
+	"ERC4626Form": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewERC4626FormCaller(a, b)
+	},
+	"ERC5115Form": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewERC5115FormCaller(a, b)
+	},
+	"ERC7540Form": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewERC7540FormCaller(a, b)
+	},
+	"EmergencyQueue": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewEmergencyQueueCaller(a, b)
+	},
+	"HyperlaneImplementation": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewHyperlaneImplementationCaller(a, b)
+	},
+	"LayerzeroImplementation": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewLayerzeroV2ImplementationCaller(a, b)
+	},
+	"LayerzeroV1Implementation": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewLayerzeroImplementationCaller(a, b)
+	},
+	"PayMaster": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewPayMasterCaller(a, b)
+	},
+	"PayloadHelper": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewPayloadHelperV2Caller(a, b)
+	},
+	"PaymentHelper": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewPaymentHelperCaller(a, b)
+	},
+	"RewardsDistributor": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewRewardsDistributorCaller(a, b)
+	},
+	"SuperPositions": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewSuperPositionsCaller(a, b)
+	},
+	"SuperformFactory": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewSFFactoryCaller(a, b)
+	},
+	"SuperformRouter": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewSFRouterCaller(a, b)
+	},
+	"SuperformRouterPlus": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewSuperformRouterPlusCaller(a, b)
+	},
+	"SuperformRouterPlusAsync": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewSuperformRouterPlusAsyncCaller(a, b)
+	},
+	"WormholeARImplementation": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewWormholeARImplementationCaller(a, b)
+	},
+	"WormholeSRImplementation": func(a common.Address, b bind.ContractCaller) (superRegistryCaller, error) {
+		return contracts.NewWormholeSRImplementationCaller(a, b)
+	},
+}
+
+// Verifier cross-checks the SuperRegistry of every chain against the deployment address books.
+type Verifier struct {
+	deployments deployments.Deployments
+	backends    map[uint64]bind.ContractBackend
+}
+
+// NewVerifier creates a Verifier over the address books in deps, reading the chains backends are given for.
+func NewVerifier(deps deployments.Deployments, backends map[uint64]bind.ContractBackend) *Verifier {
+	return &Verifier{deployments: deps, backends: backends}
+}
+
+// Verify verifies every chain with both an address book and a backend, in chain id order, and logs each mismatch.
+func (v *Verifier) Verify(ctx context.Context) ([]Report, error) {
+	var out []Report
+	for _, id := range v.deployments.ChainIDs() {
+		if _, ok := v.backends[id]; !ok {
+			continue
+		}
+		r, err := v.VerifyChain(ctx, id)
+		if err != nil {
+			return out, err
+		}
+		for _, m := range r.Mismatches {
+			log.Warn("SuperRegistry mismatch", "chain", r.Chain, "chainId", m.ChainID, "subject", m.Subject, "expected", m.Expected, "actual", m.Actual)
+		}
+		log.Info("Verified SuperRegistry", "chain", r.Chain, "chainId", r.ChainID, "registry", r.Registry, "checked", r.Checked, "mismatches", len(r.Mismatches))
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// VerifyChain checks, on chainID:
+//   - the module ids against the address book contracts they are registered for, on chainID and, where set, for
+//     every other chain with an address book;
+//   - the bridge validators, AMB implementations and state registries against the address book;
+//   - the SuperRegistry() pointer of every bound contract against the address book SuperRegistry.
+//
+// Entries the address book does not list are not checked.
+func (v *Verifier) VerifyChain(ctx context.Context, chainID uint64) (Report, error) {
+	book, ok := v.deployments[chainID]
+	if !ok {
+		return Report{}, fmt.Errorf("registry: no address book for chain %d", chainID)
+	}
+	backend, ok := v.backends[chainID]
+	if !ok {
+		return Report{}, fmt.Errorf("registry: no backend for chain %d", chainID)
+	}
+	registry, err := book.MustAddress("SuperRegistry")
+	if err != nil {
+		return Report{}, err
+	}
+	client, err := NewClient(backend, registry)
+	if err != nil {
+		return Report{}, err
+	}
+	snap, err := client.Snapshot(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("registry: chain %d: %w", chainID, err)
+	}
+	if snap.ChainID != chainID {
+		return Report{}, fmt.Errorf("registry: SuperRegistry %s on chain %d reports CHAIN_ID %d", registry, chainID, snap.ChainID)
+	}
+
+	r := Report{ChainID: chainID, Chain: book.Name, Registry: registry}
+	check := func(subject string, expected, actual common.Address) {
+		r.Checked++
+		if expected != actual {
+			r.Mismatches = append(r.Mismatches, Mismatch{ChainID: chainID, Subject: subject, Expected: expected, Actual: actual})
+		}
+	}
+
+	for _, m := range Modules {
+		if expected, ok := book.Contracts[m.Contract]; ok {
+			check(m.ID.String(), expected, snap.Addresses[m.ID])
+		}
+	}
+	for _, other := range v.deployments.ChainIDs() {
+		if other == chainID {
+			continue
+		}
+		remote := v.deployments[other]
+		for _, m := range Modules {
+			expected, ok := remote.Address(m.Contract)
+			if !ok {
+				continue
+			}
+			actual, err := client.ResolveOn(ctx, m.ID, other)
+			if errors.Is(err, ErrUnset) {
+				continue
+			}
+			if err != nil {
+				return Report{}, fmt.Errorf("registry: chain %d: %w", chainID, err)
+			}
+			check(fmt.Sprintf("%s on %d", m.ID, other), expected, actual)
+		}
+	}
+
+	for _, id := range sortedUint8(BridgeValidators) {
+		if expected, ok := book.Contracts[BridgeValidators[id]]; ok {
+			check(fmt.Sprintf("bridge validator %d", id), expected, snap.Bridges[id].Validator)
+		}
+	}
+	for _, id := range sortedUint8(AMBImplementations) {
+		if expected, ok := book.Contracts[AMBImplementations[id]]; ok {
+			check(fmt.Sprintf("amb %d", id), expected, snap.AMBs[id].Address)
+		}
+	}
+	for _, id := range sortedUint8(StateRegistries) {
+		if expected, ok := book.Contracts[StateRegistries[id]]; ok {
+			check(fmt.Sprintf("state registry %d", id), expected, snap.StateRegistries[id])
+		}
+	}
+
+	names := make([]string, 0, len(pointers))
+	for name := range pointers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	opts := &bind.CallOpts{Context: ctx}
+	for _, name := range names {
+		addr, ok := book.Address(name)
+		if !ok {
+			continue
+		}
+		caller, err := pointers[name](addr, backend)
+		if err != nil {
+			return Report{}, err
+		}
+		actual, err := caller.SuperRegistry(opts)
+		if err != nil {
+			return Report{}, fmt.Errorf("registry: chain %d: %s.superRegistry(): %w", chainID, name, err)
+		}
+		check(name+".superRegistry()", registry, actual)
+	}
+	return r, nil
+}
@@ -0,0 +1,218 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	// ErrReverted is returned when a sent transaction reverts.
+	ErrReverted = errors.New("rewards: transaction reverted")
+	// ErrStalePeriod is returned when a period's id is no longer the distributor's next period id, so its leaves
+	// would not verify under the id setPeriodicRewards assigns.
+	ErrStalePeriod = errors.New("rewards: period id is not the next period")
+	// ErrWrongChain is returned for a period built for another chain.
+	ErrWrongChain = errors.New("rewards: period is for another chain")
+	// ErrNothingToClaim is returned by Claim without claims.
+	ErrNothingToClaim = errors.New("rewards: nothing to claim")
+)
+
+// Backend is the chain access the client needs.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Client reads and sends RewardsDistributor periods and claims on one chain.
+type Client struct {
+	backend     Backend
+	address     common.Address
+	distributor *contracts.RewardsDistributor
+}
+
+// NewClient creates a Client for the RewardsDistributor at address.
+func NewClient(backend Backend, address common.Address) (*Client, error) {
+	distributor, err := contracts.NewRewardsDistributor(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{backend: backend, address: address, distributor: distributor}, nil
+}
+
+// Address returns the RewardsDistributor address.
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// NextPeriod returns the distributor's CHAIN_ID and the id the next setPeriodicRewards call assigns.
+func (c *Client) NextPeriod(ctx context.Context) (uint64, *big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	chainID, err := c.distributor.CHAINID(opts)
+	if err != nil {
+		return 0, nil, err
+	}
+	periodID, err := c.distributor.CurrentPeriodId(opts)
+	if err != nil {
+		return 0, nil, err
+	}
+	return chainID, periodID, nil
+}
+
+// Build builds the next period of allocations. Its Root is what the rewards admin sets.
+func (c *Client) Build(ctx context.Context, allocations []Allocation) (Period, error) {
+	chainID, periodID, err := c.NextPeriod(ctx)
+	if err != nil {
+		return Period{}, err
+	}
+	tree, err := NewTree(chainID, periodID, allocations)
+	if err != nil {
+		return Period{}, err
+	}
+	p := tree.Period()
+	log.Info("Built rewards period", "chainId", chainID, "periodId", periodID, "root", p.Root, "receivers", len(p.Claims))
+	return p, nil
+}
+
+// SetRoot sets p's root from opts.From, which must hold REWARDS_ADMIN_ROLE, and waits for the transaction. It
+// refuses a period whose id is no longer the next one.
+func (c *Client) SetRoot(opts *bind.TransactOpts, p Period) (*types.Receipt, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	chainID, periodID, err := c.NextPeriod(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.ChainID != chainID {
+		return nil, fmt.Errorf("%w: built for %d, distributor on %d", ErrWrongChain, p.ChainID, chainID)
+	}
+	if p.PeriodID.Cmp(periodID) != 0 {
+		return nil, fmt.Errorf("%w: built for %s, next is %s", ErrStalePeriod, p.PeriodID, periodID)
+	}
+	tx, err := c.distributor.SetPeriodicRewards(opts, p.Root)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := bind.WaitMined(ctx, c.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+	}
+	log.Info("Set rewards period", "chainId", chainID, "periodId", p.PeriodID, "root", p.Root, "tx", tx.Hash())
+	return receipt, nil
+}
+
+// Claimable returns receiver's claims in periods that can still be claimed on chain: the period is for this
+// chain, its root is the one set for its id, the deadline has not passed and receiver has not claimed it.
+func (c *Client) Claimable(ctx context.Context, receiver common.Address, periods []Period) ([]Claim, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	chainID, err := c.distributor.CHAINID(opts)
+	if err != nil {
+		return nil, err
+	}
+	deadline, err := c.distributor.DEADLINE(opts)
+	if err != nil {
+		return nil, err
+	}
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	now := new(big.Int).SetUint64(head.Time)
+
+	var out []Claim
+	for _, p := range periods {
+		claim, ok := p.Claims[receiver]
+		if !ok || p.ChainID != chainID {
+			continue
+		}
+		data, err := c.distributor.PeriodicRewardsMerkleRootData(opts, p.PeriodID)
+		if err != nil {
+			return nil, fmt.Errorf("rewards: period %s: %w", p.PeriodID, err)
+		}
+		if data.MerkleRoot != p.Root {
+			log.Warn("Rewards period root mismatch", "chainId", chainID, "periodId", p.PeriodID, "root", p.Root, "onchain", common.Hash(data.MerkleRoot))
+			continue
+		}
+		if now.Cmp(new(big.Int).Add(data.StartTimestamp, deadline)) > 0 {
+			continue
+		}
+		claimed, err := c.distributor.PeriodicRewardsClaimed(opts, p.PeriodID, receiver)
+		if err != nil {
+			return nil, fmt.Errorf("rewards: period %s: %w", p.PeriodID, err)
+		}
+		if !claimed {
+			out = append(out, claim)
+		}
+	}
+	return out, nil
+}
+
+// Claim sends claims, with claim for a single one and batchClaim otherwise, and waits for the transaction. Every
+// claim must have the same receiver; anyone may send it on the receiver's behalf.
+func (c *Client) Claim(opts *bind.TransactOpts, claims []Claim) (*types.Receipt, error) {
+	if len(claims) == 0 {
+		return nil, ErrNothingToClaim
+	}
+	receiver := claims[0].Receiver
+	for _, cl := range claims[1:] {
+		if cl.Receiver != receiver {
+			return nil, fmt.Errorf("rewards: claims for both %s and %s", receiver, cl.Receiver)
+		}
+	}
+
+	var (
+		tx  *types.Transaction
+		err error
+	)
+	if len(claims) == 1 {
+		cl := claims[0]
+		tx, err = c.distributor.Claim(opts, receiver, cl.PeriodID, cl.Tokens, cl.Amounts, proof(cl.Proof))
+	} else {
+		periodIDs := make([]*big.Int, len(claims))
+		tokens := make([][]common.Address, len(claims))
+		amounts := make([][]*big.Int, len(claims))
+		proofs := make([][][32]byte, len(claims))
+		for i, cl := range claims {
+			periodIDs[i], tokens[i], amounts[i], proofs[i] = cl.PeriodID, cl.Tokens, cl.Amounts, proof(cl.Proof)
+		}
+		tx, err = c.distributor.BatchClaim(opts, receiver, periodIDs, tokens, amounts, proofs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	receipt, err := bind.WaitMined(ctx, c.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+	}
+	log.Info("Claimed rewards", "receiver", receiver, "periods", len(claims), "tx", tx.Hash())
+	return receipt, nil
+}
+
+func proof(hashes []common.Hash) [][32]byte {
+	out := make([][32]byte, len(hashes))
+	for i, h := range hashes {
+		out[i] = h
+	}
+	return out
+}
@@ -0,0 +1,226 @@
+package rewards
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrNoAllocations is returned when building a tree without allocations.
+	ErrNoAllocations = errors.New("rewards: no allocations")
+	// ErrDuplicateReceiver is returned when a receiver has more than one allocation; the distributor lets each
+	// receiver claim once per period.
+	ErrDuplicateReceiver = errors.New("rewards: duplicate receiver")
+	// ErrInvalidAllocation is returned for an allocation RewardsDistributor.claim would refuse.
+	ErrInvalidAllocation = errors.New("rewards: invalid allocation")
+)
+
+var leafArgs = abi.Arguments{
+	{Type: mustType("address")},
+	{Type: mustType("uint256")},
+	{Type: mustType("address[]")},
+	{Type: mustType("uint256[]")},
+	{Type: mustType("uint64")},
+}
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// Leaf returns the leaf verifyClaim checks for a on chainID in periodID:
+// keccak256(bytes.concat(keccak256(abi.encode(receiver, periodId, rewardTokens, amountsClaimed, CHAIN_ID)))).
+func Leaf(chainID uint64, periodID *big.Int, a Allocation) (common.Hash, error) {
+	if a.Receiver == (common.Address{}) || len(a.Tokens) == 0 || len(a.Tokens) != len(a.Amounts) {
+		return common.Hash{}, fmt.Errorf("%w: receiver %s", ErrInvalidAllocation, a.Receiver)
+	}
+	encoded, err := leafArgs.Pack(a.Receiver, periodID, a.Tokens, a.Amounts, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded)), nil
+}
+
+// hashPair is OpenZeppelin's commutative pair hash used by MerkleProof.verify.
+func hashPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
+
+// Tree is the merkle tree of one period, laid out like OpenZeppelin's StandardMerkleTree: leaves sorted by hash
+// fill the end of a complete binary tree stored as an array, so roots and proofs match the JS tooling.
+type Tree struct {
+	chainID  uint64
+	periodID *big.Int
+	nodes    []common.Hash
+	// index is the position of each receiver's leaf in nodes.
+	index map[common.Address]int
+	claim map[common.Address]Allocation
+}
+
+// NewTree builds the tree of allocations on chainID for periodID, the id setPeriodicRewards will assign the root.
+func NewTree(chainID uint64, periodID *big.Int, allocations []Allocation) (*Tree, error) {
+	if len(allocations) == 0 {
+		return nil, ErrNoAllocations
+	}
+	type leaf struct {
+		hash     common.Hash
+		receiver common.Address
+	}
+	leaves := make([]leaf, 0, len(allocations))
+	t := &Tree{
+		chainID:  chainID,
+		periodID: new(big.Int).Set(periodID),
+		index:    make(map[common.Address]int, len(allocations)),
+		claim:    make(map[common.Address]Allocation, len(allocations)),
+	}
+	for _, a := range allocations {
+		if _, ok := t.claim[a.Receiver]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateReceiver, a.Receiver)
+		}
+		h, err := Leaf(chainID, periodID, a)
+		if err != nil {
+			return nil, err
+		}
+		t.claim[a.Receiver] = a
+		leaves = append(leaves, leaf{h, a.Receiver})
+	}
+	sort.Slice(leaves, func(i, j int) bool { return bytes.Compare(leaves[i].hash[:], leaves[j].hash[:]) < 0 })
+
+	t.nodes = make([]common.Hash, 2*len(leaves)-1)
+	for i, l := range leaves {
+		pos := len(t.nodes) - 1 - i
+		t.nodes[pos] = l.hash
+		t.index[l.receiver] = pos
+	}
+	for i := len(t.nodes) - 1 - len(leaves); i >= 0; i-- {
+		t.nodes[i] = hashPair(t.nodes[2*i+1], t.nodes[2*i+2])
+	}
+	return t, nil
+}
+
+// Root returns the merkle root to pass to setPeriodicRewards.
+func (t *Tree) Root() common.Hash {
+	return t.nodes[0]
+}
+
+// Proof returns the proof of receiver's leaf, or false when receiver has no allocation.
+func (t *Tree) Proof(receiver common.Address) ([]common.Hash, bool) {
+	i, ok := t.index[receiver]
+	if !ok {
+		return nil, false
+	}
+	var proof []common.Hash
+	for i > 0 {
+		sibling := i + 1
+		if i%2 == 0 {
+			sibling = i - 1
+		}
+		proof = append(proof, t.nodes[sibling])
+		i = (i - 1) / 2
+	}
+	return proof, true
+}
+
+// Period returns the tree's root and every receiver's claim.
+func (t *Tree) Period() Period {
+	p := Period{
+		ChainID:  t.chainID,
+		PeriodID: new(big.Int).Set(t.periodID),
+		Root:     t.Root(),
+		Claims:   make(map[common.Address]Claim, len(t.claim)),
+	}
+	for receiver, a := range t.claim {
+		proof, _ := t.Proof(receiver)
+		p.Claims[receiver] = Claim{
+			Receiver: receiver,
+			PeriodID: p.PeriodID,
+			Tokens:   a.Tokens,
+			Amounts:  a.Amounts,
+			Proof:    proof,
+		}
+	}
+	return p
+}
+
+// Verify checks proof against root the way MerkleProof.verify does.
+func Verify(root, leaf common.Hash, proof []common.Hash) bool {
+	h := leaf
+	for _, p := range proof {
+		h = hashPair(h, p)
+	}
+	return h == root
+}
+
+// Claim is the claim arguments of one receiver for one period.
+type Claim struct {
+	Receiver common.Address   `json:"receiver"`
+	PeriodID *big.Int         `json:"periodId"`
+	Tokens   []common.Address `json:"tokens"`
+	Amounts  []*big.Int       `json:"amounts"`
+	Proof    []common.Hash    `json:"proof"`
+}
+
+// Period is a published reward period: the root set on chain and the claims proving against it.
+type Period struct {
+	ChainID  uint64                   `json:"chainId"`
+	PeriodID *big.Int                 `json:"periodId"`
+	Root     common.Hash              `json:"root"`
+	Claims   map[common.Address]Claim `json:"claims"`
+}
+
+// Verify rebuilds every claim's leaf and checks its proof against the root.
+func (p Period) Verify() error {
+	for receiver, c := range p.Claims {
+		leaf, err := Leaf(p.ChainID, p.PeriodID, Allocation{Receiver: c.Receiver, Tokens: c.Tokens, Amounts: c.Amounts})
+		if err != nil {
+			return err
+		}
+		if receiver != c.Receiver || c.PeriodID == nil || c.PeriodID.Cmp(p.PeriodID) != 0 || !Verify(p.Root, leaf, c.Proof) {
+			return fmt.Errorf("%w: chain %d period %s: proof of %s", ErrInvalidAllocation, p.ChainID, p.PeriodID, receiver)
+		}
+	}
+	return nil
+}
+
+// WritePeriod writes p as JSON to file.
+func WritePeriod(file string, p Period) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0o644)
+}
+
+// LoadPeriod reads a JSON period from file and verifies its proofs.
+func LoadPeriod(file string) (Period, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return Period{}, err
+	}
+	var p Period
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Period{}, fmt.Errorf("rewards: %s: %w", file, err)
+	}
+	if p.PeriodID == nil {
+		return Period{}, fmt.Errorf("rewards: %s: missing periodId", file)
+	}
+	if err := p.Verify(); err != nil {
+		return Period{}, fmt.Errorf("%w (%s)", err, file)
+	}
+	return p, nil
+}
@@ -0,0 +1,132 @@
+package rewards
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// vectorDir holds the trees test/utils/merkle/merkle-js/generateMerkleTree.js dumps with OpenZeppelin's
+// StandardMerkleTree for the Solidity RewardsDistributor tests.
+var vectorDir = filepath.Join("..", "..", "test", "utils", "merkle", "target")
+
+type treeDump struct {
+	Tree   []common.Hash `json:"tree"`
+	Values []struct {
+		Claimer        common.Address   `json:"claimer"`
+		PeriodID       int64            `json:"periodId"`
+		RewardTokens   []common.Address `json:"rewardTokens"`
+		AmountsClaimed []int64          `json:"amountsClaimed"`
+		ChainID        uint64           `json:"chainId"`
+		Proof          []common.Hash    `json:"proof"`
+	} `json:"values"`
+}
+
+func loadVector(t *testing.T, i int) (treeDump, common.Hash) {
+	t.Helper()
+	var dump treeDump
+	data, err := os.ReadFile(filepath.Join(vectorDir, fmt.Sprintf("jsTreeDump%d.json", i)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &dump); err != nil {
+		t.Fatal(err)
+	}
+	var root struct {
+		Root common.Hash `json:"root"`
+	}
+	if data, err = os.ReadFile(filepath.Join(vectorDir, fmt.Sprintf("jsGeneratedRoot%d.json", i))); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &root); err != nil {
+		t.Fatal(err)
+	}
+	return dump, root.Root
+}
+
+func TestTreeMatchesStandardMerkleTree(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		t.Run(fmt.Sprintf("jsTreeDump%d", i), func(t *testing.T) {
+			dump, root := loadVector(t, i)
+			if len(dump.Values) == 0 {
+				t.Fatal("empty vector")
+			}
+			chainID := dump.Values[0].ChainID
+			periodID := big.NewInt(dump.Values[0].PeriodID)
+
+			allocations := make([]Allocation, len(dump.Values))
+			for j, v := range dump.Values {
+				amounts := make([]*big.Int, len(v.AmountsClaimed))
+				for k, a := range v.AmountsClaimed {
+					amounts[k] = big.NewInt(a)
+				}
+				allocations[j] = Allocation{Receiver: v.Claimer, Tokens: v.RewardTokens, Amounts: amounts}
+			}
+			tree, err := NewTree(chainID, periodID, allocations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tree.Root() != root {
+				t.Fatalf("root %s, want %s", tree.Root(), root)
+			}
+			if len(tree.nodes) != len(dump.Tree) {
+				t.Fatalf("%d nodes, want %d", len(tree.nodes), len(dump.Tree))
+			}
+			for j, v := range dump.Values {
+				proof, ok := tree.Proof(v.Claimer)
+				if !ok {
+					t.Fatalf("no proof for %s", v.Claimer)
+				}
+				if len(proof) != len(v.Proof) {
+					t.Fatalf("%s: proof length %d, want %d", v.Claimer, len(proof), len(v.Proof))
+				}
+				for k := range proof {
+					if proof[k] != v.Proof[k] {
+						t.Fatalf("%s: proof[%d] %s, want %s", v.Claimer, k, proof[k], v.Proof[k])
+					}
+				}
+				leaf, err := Leaf(chainID, periodID, allocations[j])
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !Verify(root, leaf, proof) {
+					t.Fatalf("%s: proof does not verify", v.Claimer)
+				}
+			}
+			if err := tree.Period().Verify(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestNewTreeRejects(t *testing.T) {
+	receiver := common.HexToAddress("0x01")
+	token := common.HexToAddress("0x0b2C639c533813f4Aa9D7837CAf62653d097Ff85")
+	valid := Allocation{Receiver: receiver, Tokens: []common.Address{token}, Amounts: []*big.Int{big.NewInt(1)}}
+
+	tests := []struct {
+		name        string
+		allocations []Allocation
+		want        error
+	}{
+		{"empty", nil, ErrNoAllocations},
+		{"duplicate receiver", []Allocation{valid, valid}, ErrDuplicateReceiver},
+		{"zero receiver", []Allocation{{Tokens: valid.Tokens, Amounts: valid.Amounts}}, ErrInvalidAllocation},
+		{"no tokens", []Allocation{{Receiver: receiver}}, ErrInvalidAllocation},
+		{"length mismatch", []Allocation{{Receiver: receiver, Tokens: valid.Tokens}}, ErrInvalidAllocation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTree(10, big.NewInt(0), tt.allocations); !errors.Is(err, tt.want) {
+				t.Fatalf("err %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
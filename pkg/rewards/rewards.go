@@ -0,0 +1,212 @@
+// Package rewards runs RewardsDistributor reward periods.
+//
+// A period starts from a SuperPositions balance snapshot: each reward pool is split between the holders of one
+// superform pro rata to their balance. The allocations are committed to in a merkle tree whose leaves are the exact
+// ones RewardsDistributor.verifyClaim rebuilds, the root is set by a REWARDS_ADMIN_ROLE holder through
+// setPeriodicRewards, and every receiver claims with the proof published for it.
+package rewards
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrEmptyPool is returned when a pool's superform has no holders in the snapshot, which would leave its rewards
+// unallocated.
+var ErrEmptyPool = errors.New("rewards: pool superform has no holders")
+
+// DefaultBlockRange is the number of blocks TakeSnapshot queries logs for at once unless it is given another.
+const DefaultBlockRange = 5000
+
+// Holding is the SuperPositions balance of one owner in one superform.
+type Holding struct {
+	Owner       common.Address
+	SuperformID *big.Int
+	Balance     *big.Int
+}
+
+// Snapshot is the SuperPositions balances of a chain at a block.
+type Snapshot struct {
+	Block uint64
+	// Holdings are the non zero balances, in superform id then owner order.
+	Holdings []Holding
+}
+
+// Supply returns the total balance of superformID.
+func (s Snapshot) Supply(superformID *big.Int) *big.Int {
+	total := new(big.Int)
+	for _, h := range s.Holdings {
+		if h.SuperformID.Cmp(superformID) == 0 {
+			total.Add(total, h.Balance)
+		}
+	}
+	return total
+}
+
+// TakeSnapshot replays the SuperPositions TransferSingle and TransferBatch events from fromBlock, the SuperPositions
+// deployment block, up to block, blockRange blocks at a time; zero uses DefaultBlockRange. Only the ERC1155A balances are counted: positions transmuted to their aERC20 are
+// burnt from SuperPositions and left out. An empty superformIDs keeps every superform.
+func TakeSnapshot(ctx context.Context, backend bind.ContractBackend, superPositions common.Address, fromBlock, block, blockRange uint64, superformIDs []*big.Int) (Snapshot, error) {
+	sp, err := contracts.NewSuperPositionsFilterer(superPositions, backend)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	keep := make(map[string]bool, len(superformIDs))
+	for _, id := range superformIDs {
+		keep[id.String()] = true
+	}
+
+	balances := make(map[string]map[common.Address]*big.Int)
+	ids := make(map[string]*big.Int)
+	move := func(from, to common.Address, id, value *big.Int) {
+		key := id.String()
+		if len(keep) > 0 && !keep[key] {
+			return
+		}
+		if balances[key] == nil {
+			balances[key] = make(map[common.Address]*big.Int)
+			ids[key] = id
+		}
+		if from != (common.Address{}) {
+			balances[key][from] = new(big.Int).Sub(balance(balances[key], from), value)
+		}
+		if to != (common.Address{}) {
+			balances[key][to] = new(big.Int).Add(balance(balances[key], to), value)
+		}
+	}
+
+	if blockRange == 0 {
+		blockRange = DefaultBlockRange
+	}
+	for start := fromBlock; start <= block; start += blockRange {
+		end := min(start+blockRange-1, block)
+		if err := replay(ctx, sp, start, end, move); err != nil {
+			return Snapshot{}, fmt.Errorf("rewards: logs %d-%d: %w", start, end, err)
+		}
+	}
+
+	s := Snapshot{Block: block}
+	for key, owners := range balances {
+		for owner, b := range owners {
+			if b.Sign() > 0 {
+				s.Holdings = append(s.Holdings, Holding{Owner: owner, SuperformID: ids[key], Balance: b})
+			}
+		}
+	}
+	sort.Slice(s.Holdings, func(i, j int) bool {
+		a, b := s.Holdings[i], s.Holdings[j]
+		if c := a.SuperformID.Cmp(b.SuperformID); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(a.Owner[:], b.Owner[:]) < 0
+	})
+	return s, nil
+}
+
+// replay applies the transfers of blocks start to end to move. Balances are sums, so singles and batches need not
+// be interleaved in log order.
+func replay(ctx context.Context, sp *contracts.SuperPositionsFilterer, start, end uint64, move func(from, to common.Address, id, value *big.Int)) error {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+	single, err := sp.FilterTransferSingle(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	for single.Next() {
+		move(single.Event.From, single.Event.To, single.Event.Id, single.Event.Value)
+	}
+	err = single.Error()
+	single.Close()
+	if err != nil {
+		return err
+	}
+
+	batch, err := sp.FilterTransferBatch(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	for batch.Next() {
+		for i, id := range batch.Event.Ids {
+			move(batch.Event.From, batch.Event.To, id, batch.Event.Values[i])
+		}
+	}
+	err = batch.Error()
+	batch.Close()
+	return err
+}
+
+func balance(m map[common.Address]*big.Int, owner common.Address) *big.Int {
+	if b, ok := m[owner]; ok {
+		return b
+	}
+	return new(big.Int)
+}
+
+// Pool is an amount of a reward token shared by the holders of a superform.
+type Pool struct {
+	SuperformID *big.Int
+	Token       common.Address
+	Amount      *big.Int
+}
+
+// Allocation is what one receiver may claim in a period.
+type Allocation struct {
+	Receiver common.Address
+	// Tokens are in address order, Amounts matches them.
+	Tokens  []common.Address
+	Amounts []*big.Int
+}
+
+// Allocate splits every pool between the holders of its superform in snapshot, pro rata to their balance and
+// rounded down. Allocations of the same token from several pools add up. The rounding remainder stays in the
+// distributor and can be recovered with rescueRewards. Allocations are in receiver order.
+func Allocate(snapshot Snapshot, pools []Pool) ([]Allocation, error) {
+	owed := make(map[common.Address]map[common.Address]*big.Int)
+	for _, p := range pools {
+		supply := snapshot.Supply(p.SuperformID)
+		if supply.Sign() == 0 {
+			return nil, fmt.Errorf("%w: superform %s, token %s", ErrEmptyPool, p.SuperformID, p.Token)
+		}
+		for _, h := range snapshot.Holdings {
+			if h.SuperformID.Cmp(p.SuperformID) != 0 {
+				continue
+			}
+			share := new(big.Int).Mul(p.Amount, h.Balance)
+			share.Div(share, supply)
+			if share.Sign() == 0 {
+				continue
+			}
+			if owed[h.Owner] == nil {
+				owed[h.Owner] = make(map[common.Address]*big.Int)
+			}
+			owed[h.Owner][p.Token] = new(big.Int).Add(balance(owed[h.Owner], p.Token), share)
+		}
+	}
+
+	out := make([]Allocation, 0, len(owed))
+	for receiver, tokens := range owed {
+		a := Allocation{Receiver: receiver}
+		for token := range tokens {
+			a.Tokens = append(a.Tokens, token)
+		}
+		sortAddresses(a.Tokens)
+		for _, token := range a.Tokens {
+			a.Amounts = append(a.Amounts, tokens[token])
+		}
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i].Receiver[:], out[j].Receiver[:]) < 0 })
+	return out, nil
+}
+
+func sortAddresses(addrs []common.Address) {
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+}
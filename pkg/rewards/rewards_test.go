@@ -0,0 +1,102 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+var (
+	testSuperPositions = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	alice              = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	bob                = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+// fakeLogs serves SuperPositions transfer logs. Calls it does not expect panic through the nil ContractBackend.
+type fakeLogs struct {
+	bind.ContractBackend
+	logs []types.Log
+	// queries are the block ranges asked for.
+	queries [][2]uint64
+}
+
+func (f *fakeLogs) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	f.queries = append(f.queries, [2]uint64{from, to})
+	var out []types.Log
+	for _, l := range f.logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to && l.Topics[0] == q.Topics[0][0] {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func transferLog(t *testing.T, block uint64, from, to common.Address, ids, values []int64) types.Log {
+	parsed, err := contracts.SuperPositionsMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := parsed.Events["TransferSingle"]
+	var data []byte
+	if len(ids) == 1 {
+		data, err = event.Inputs.NonIndexed().Pack(big.NewInt(ids[0]), big.NewInt(values[0]))
+	} else {
+		event = parsed.Events["TransferBatch"]
+		bigIDs, bigValues := make([]*big.Int, len(ids)), make([]*big.Int, len(values))
+		for i := range ids {
+			bigIDs[i], bigValues[i] = big.NewInt(ids[i]), big.NewInt(values[i])
+		}
+		data, err = event.Inputs.NonIndexed().Pack(bigIDs, bigValues)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Address:     testSuperPositions,
+		Topics:      []common.Hash{event.ID, {}, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:        data,
+		BlockNumber: block,
+	}
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	backend := &fakeLogs{logs: []types.Log{
+		transferLog(t, 100, common.Address{}, alice, []int64{1}, []int64{50}),
+		transferLog(t, 150, common.Address{}, bob, []int64{1, 2}, []int64{10, 7}),
+		transferLog(t, 210, alice, bob, []int64{1}, []int64{20}),
+		transferLog(t, 299, bob, common.Address{}, []int64{1, 2}, []int64{30, 3}),
+		// after the snapshot block
+		transferLog(t, 301, alice, bob, []int64{1}, []int64{30}),
+	}}
+	s, err := TakeSnapshot(context.Background(), backend, testSuperPositions, 100, 300, 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Holding{
+		{Owner: alice, SuperformID: big.NewInt(1), Balance: big.NewInt(30)},
+		{Owner: bob, SuperformID: big.NewInt(2), Balance: big.NewInt(4)},
+	}
+	if fmt.Sprint(s.Holdings) != fmt.Sprint(want) {
+		t.Fatalf("holdings %v, want %v", s.Holdings, want)
+	}
+	if got := fmt.Sprint(backend.queries); got != "[[100 199] [100 199] [200 299] [200 299] [300 300] [300 300]]" {
+		t.Fatalf("queried %s", got)
+	}
+
+	s, err = TakeSnapshot(context.Background(), backend, testSuperPositions, 100, 300, 0, []*big.Int{big.NewInt(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Holdings) != 1 || s.Supply(big.NewInt(2)).Int64() != 4 || s.Supply(big.NewInt(1)).Sign() != 0 {
+		t.Fatalf("holdings %v", s.Holdings)
+	}
+}
@@ -0,0 +1,235 @@
+// Package transmuter manages the aERC20 side of SuperPositions: it reports how each position is split between its
+// ERC1155A balance and its aERC20 balance, registers missing aERC20 tokens and transmutes balances in either
+// direction.
+package transmuter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/superform-xyz/superform-core/contracts"
+	"github.com/superform-xyz/superform-core/pkg/broadcast"
+)
+
+// Direction selects which representation a transmute converts into.
+type Direction uint8
+
+const (
+	// ToERC20 converts ERC1155A SuperPositions into their aERC20 token.
+	ToERC20 Direction = iota
+	// ToERC1155A converts aERC20 tokens back into ERC1155A SuperPositions.
+	ToERC1155A
+)
+
+func (d Direction) String() string {
+	if d == ToERC20 {
+		return "ERC1155A->aERC20"
+	}
+	return "aERC20->ERC1155A"
+}
+
+var (
+	// ErrUnderfundedBroadcast is returned when the value sent with registerAERC20 does not cover the broadcast
+	// gas fee configured in PaymentHelper; the call would revert with INVALID_BROADCAST_FEE.
+	ErrUnderfundedBroadcast = errors.New("transmuter: register value below broadcast gas fee")
+	// ErrNotRegistered is returned when transmuting a position whose aERC20 was never registered.
+	ErrNotRegistered = errors.New("transmuter: aERC20 not registered")
+	// ErrLengthMismatch is returned when ids and amounts differ in length.
+	ErrLengthMismatch = errors.New("transmuter: ids and amounts length mismatch")
+)
+
+// Split is the balance of one SuperPosition id held as ERC1155A and as aERC20.
+type Split struct {
+	ID      *big.Int
+	ERC1155 *big.Int
+	AERC20  *big.Int
+	// Token is the aERC20 address, zero when the id has no aERC20 registered.
+	Token common.Address
+}
+
+// Registered reports whether the position has an aERC20 token.
+func (s Split) Registered() bool {
+	return s.Token != (common.Address{})
+}
+
+// Total is the combined balance across both representations.
+func (s Split) Total() *big.Int {
+	return new(big.Int).Add(s.ERC1155, s.AERC20)
+}
+
+// Manager reads and moves SuperPositions between their ERC1155A and aERC20 forms.
+type Manager struct {
+	backend       bind.ContractBackend
+	positions     *contracts.SuperPositions
+	paymentHelper *contracts.PaymentHelperCaller
+}
+
+// NewManager creates a Manager for the SuperPositions and PaymentHelper deployments of one chain.
+func NewManager(backend bind.ContractBackend, superPositions, paymentHelper common.Address) (*Manager, error) {
+	positions, err := contracts.NewSuperPositions(superPositions, backend)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := contracts.NewPaymentHelperCaller(paymentHelper, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		backend:       backend,
+		positions:     positions,
+		paymentHelper: helper,
+	}, nil
+}
+
+// Balances returns the ERC1155A/aERC20 split of owner for every id.
+func (m *Manager) Balances(ctx context.Context, owner common.Address, ids []*big.Int) ([]Split, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	owners := make([]common.Address, len(ids))
+	for i := range owners {
+		owners[i] = owner
+	}
+	erc1155, err := m.positions.BalanceOfBatch(opts, owners, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	splits := make([]Split, len(ids))
+	for i, id := range ids {
+		splits[i] = Split{ID: id, ERC1155: erc1155[i], AERC20: new(big.Int)}
+
+		exists, err := m.positions.AERC20Exists(opts, id)
+		if err != nil {
+			return nil, fmt.Errorf("id %s: %w", id, err)
+		}
+		if !exists {
+			continue
+		}
+		if splits[i].Token, err = m.positions.GetERC20TokenAddress(opts, id); err != nil {
+			return nil, fmt.Errorf("id %s: %w", id, err)
+		}
+		token, err := contracts.NewIERC20Caller(splits[i].Token, m.backend)
+		if err != nil {
+			return nil, err
+		}
+		if splits[i].AERC20, err = token.BalanceOf(opts, owner); err != nil {
+			return nil, fmt.Errorf("id %s: %w", id, err)
+		}
+	}
+	return splits, nil
+}
+
+// RegisterFee returns the broadcast AMB payment data PaymentHelper hands to registerAERC20. Its GasFee is the
+// minimum value a register call must carry.
+func (m *Manager) RegisterFee(ctx context.Context) (broadcast.ExtraData, error) {
+	data, err := m.paymentHelper.GetRegisterTransmuterAMBData(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return broadcast.ExtraData{}, err
+	}
+	return broadcast.DecodeExtraData(data)
+}
+
+// Register registers the aERC20 of id. When opts.Value is nil the broadcast gas fee is sent; an explicit value
+// below the fee is reported as ErrUnderfundedBroadcast without sending.
+func (m *Manager) Register(opts *bind.TransactOpts, id *big.Int) (*types.Transaction, error) {
+	fee, err := m.RegisterFee(opts.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	txOpts := *opts
+	if txOpts.Value == nil {
+		txOpts.Value = fee.GasFee
+	} else if !fee.Covers(txOpts.Value) {
+		log.Warn("Underfunded aERC20 register broadcast", "id", id, "value", txOpts.Value, "gasFee", fee.GasFee, "ambId", fee.AmbID)
+		return nil, fmt.Errorf("%w: id %s sends %s, needs %s", ErrUnderfundedBroadcast, id, txOpts.Value, fee.GasFee)
+	}
+	return m.positions.RegisterAERC20(&txOpts, id)
+}
+
+// RegisterMissing registers the aERC20 of every id that does not have one yet and returns the sent transactions.
+func (m *Manager) RegisterMissing(opts *bind.TransactOpts, ids []*big.Int) ([]*types.Transaction, error) {
+	callOpts := &bind.CallOpts{Context: opts.Context}
+
+	var txs []*types.Transaction
+	for _, id := range ids {
+		exists, err := m.positions.AERC20Exists(callOpts, id)
+		if err != nil {
+			return txs, fmt.Errorf("id %s: %w", id, err)
+		}
+		if exists {
+			continue
+		}
+		tx, err := m.Register(opts, id)
+		if err != nil {
+			return txs, fmt.Errorf("id %s: %w", id, err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// Transmute converts amounts of ids held by opts.From in the given direction, using the single call for one id
+// and the batch call otherwise. Receiver gets the converted balance.
+func (m *Manager) Transmute(opts *bind.TransactOpts, dir Direction, ids, amounts []*big.Int, receiver common.Address) (*types.Transaction, error) {
+	if len(ids) != len(amounts) {
+		return nil, ErrLengthMismatch
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("transmuter: nothing to transmute")
+	}
+
+	callOpts := &bind.CallOpts{Context: opts.Context}
+	for _, id := range ids {
+		exists, err := m.positions.AERC20Exists(callOpts, id)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: id %s", ErrNotRegistered, id)
+		}
+	}
+
+	owner := opts.From
+	switch {
+	case dir == ToERC20 && len(ids) == 1:
+		return m.positions.TransmuteToERC20(opts, owner, ids[0], amounts[0], receiver)
+	case dir == ToERC20:
+		return m.positions.TransmuteBatchToERC20(opts, owner, ids, amounts, receiver)
+	case len(ids) == 1:
+		return m.positions.TransmuteToERC1155A(opts, owner, ids[0], amounts[0], receiver)
+	default:
+		return m.positions.TransmuteBatchToERC1155A(opts, owner, ids, amounts, receiver)
+	}
+}
+
+// TransmuteAll moves the full balance of every registered split into the given direction for opts.From.
+// Splits without an aERC20 or with nothing to move are skipped; nil is returned when nothing is left to send.
+func (m *Manager) TransmuteAll(opts *bind.TransactOpts, dir Direction, splits []Split) (*types.Transaction, error) {
+	var ids, amounts []*big.Int
+	for _, s := range splits {
+		if !s.Registered() {
+			continue
+		}
+		amount := s.ERC1155
+		if dir == ToERC1155A {
+			amount = s.AERC20
+		}
+		if amount.Sign() == 0 {
+			continue
+		}
+		ids = append(ids, s.ID)
+		amounts = append(amounts, amount)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return m.Transmute(opts, dir, ids, amounts, opts.From)
+}
@@ -0,0 +1,271 @@
+package treasury
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Kind is the PayMaster function that moved the funds of an Entry.
+type Kind string
+
+const (
+	WithdrawTo       Kind = "withdrawTo"
+	WithdrawNativeTo Kind = "withdrawNativeTo"
+	RebalanceTo      Kind = "rebalanceTo"
+)
+
+// Entry is one PayMaster outflow.
+type Entry struct {
+	Kind    Kind   `json:"kind"`
+	ChainID uint64 `json:"chainId"`
+	// Role is the superRegistryId_ the receiver was resolved from.
+	Role     Role           `json:"role"`
+	RoleName string         `json:"roleName"`
+	Receiver common.Address `json:"receiver"`
+	Token    common.Address `json:"token"`
+	Amount   *big.Int       `json:"amount"`
+	// DstChainID is the chain a rebalance bridged to, zero for withdrawals.
+	DstChainID uint64      `json:"dstChainId,omitempty"`
+	TxHash     common.Hash `json:"txHash"`
+	Block      uint64      `json:"block,omitempty"`
+	LogIndex   uint        `json:"logIndex"`
+}
+
+func (e Entry) key() string {
+	return fmt.Sprintf("%d/%s/%d/%s", e.ChainID, e.TxHash.Hex(), e.LogIndex, e.Kind)
+}
+
+// Ledger is an append-only record of PayMaster outflows. When backed by a file every entry is written as one JSON
+// line as soon as it is recorded, so the file is the audit trail.
+type Ledger struct {
+	path string
+
+	mu      sync.Mutex
+	entries []Entry
+	seen    map[string]bool
+}
+
+// OpenLedger loads the ledger at path, creating it on first append. An empty path keeps the ledger in memory.
+func OpenLedger(path string) (*Ledger, error) {
+	l := &Ledger{path: path, seen: make(map[string]bool)}
+	if path == "" {
+		return l, nil
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("treasury: ledger %s line %d: %w", path, line, err)
+		}
+		l.entries = append(l.entries, e)
+		l.seen[e.key()] = true
+	}
+	return l, scanner.Err()
+}
+
+// Record appends the entries not recorded yet and returns them.
+func (l *Ledger) Record(entries ...Entry) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var fresh []Entry
+	for _, e := range entries {
+		if !l.seen[e.key()] {
+			fresh = append(fresh, e)
+		}
+	}
+	if len(fresh) == 0 {
+		return nil, nil
+	}
+	if l.path != "" {
+		f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(f)
+		for _, e := range fresh {
+			if err := enc.Encode(e); err != nil {
+				f.Close()
+				return nil, err
+			}
+		}
+		if err := f.Close(); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range fresh {
+		l.entries = append(l.entries, e)
+		l.seen[e.key()] = true
+	}
+	return fresh, nil
+}
+
+// Entries returns the recorded entries matching filter, or all of them when filter is nil.
+func (l *Ledger) Entries(filter func(Entry) bool) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var out []Entry
+	for _, e := range l.entries {
+		if filter == nil || filter(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// ByRole returns the totals paid out per role and token on chainID.
+func (l *Ledger) ByRole(chainID uint64) map[Role]map[common.Address]*big.Int {
+	totals := make(map[Role]map[common.Address]*big.Int)
+	for _, e := range l.Entries(func(e Entry) bool { return e.ChainID == chainID }) {
+		tokens := totals[e.Role]
+		if tokens == nil {
+			tokens = make(map[common.Address]*big.Int)
+			totals[e.Role] = tokens
+		}
+		if tokens[e.Token] == nil {
+			tokens[e.Token] = new(big.Int)
+		}
+		tokens[e.Token].Add(tokens[e.Token], e.Amount)
+	}
+	return totals
+}
+
+// sync records the TokenWithdrawn and NativeWithdrawn events of the chain's PayMaster in [from, to] and returns
+// the new entries.
+//
+// The events only carry the receiver, so the superRegistryId_ is decoded from the withdrawing transaction. When
+// the transaction did not call PayMaster directly (e.g. it went through a multisig), the receiver is matched
+// against the addresses of knownRoles instead, first match wins. rebalanceTo emits no PayMaster event; rebalances
+// are recorded when executed through the Service.
+func (l *Ledger) sync(ctx context.Context, c *chainState, from, to uint64) ([]Entry, error) {
+	opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+
+	var entries []Entry
+	tokens, err := c.payMaster.FilterTokenWithdrawn(opts, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for tokens.Next() {
+		ev := tokens.Event
+		entries = append(entries, Entry{
+			Kind:     WithdrawTo,
+			ChainID:  c.ID,
+			Receiver: ev.Receiver,
+			Token:    ev.Token,
+			Amount:   ev.Amount,
+			TxHash:   ev.Raw.TxHash,
+			Block:    ev.Raw.BlockNumber,
+			LogIndex: ev.Raw.Index,
+		})
+	}
+	if err := tokens.Error(); err != nil {
+		tokens.Close()
+		return nil, err
+	}
+	tokens.Close()
+
+	natives, err := c.payMaster.FilterNativeWithdrawn(opts, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for natives.Next() {
+		ev := natives.Event
+		entries = append(entries, Entry{
+			Kind:     WithdrawNativeTo,
+			ChainID:  c.ID,
+			Receiver: ev.Receiver,
+			Token:    Native,
+			Amount:   ev.Amount,
+			TxHash:   ev.Raw.TxHash,
+			Block:    ev.Raw.BlockNumber,
+			LogIndex: ev.Raw.Index,
+		})
+	}
+	if err := natives.Error(); err != nil {
+		natives.Close()
+		return nil, err
+	}
+	natives.Close()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Block != entries[j].Block {
+			return entries[i].Block < entries[j].Block
+		}
+		return entries[i].LogIndex < entries[j].LogIndex
+	})
+
+	for i := range entries {
+		role, err := l.role(ctx, c, &entries[i])
+		if err != nil {
+			return nil, err
+		}
+		entries[i].Role = role
+		entries[i].RoleName = role.String()
+	}
+	return l.Record(entries...)
+}
+
+// role recovers the superRegistryId_ an entry was paid to.
+func (l *Ledger) role(ctx context.Context, c *chainState, e *Entry) (Role, error) {
+	tx, _, err := c.Backend.TransactionByHash(ctx, e.TxHash)
+	if err != nil {
+		return Role{}, fmt.Errorf("chain %d tx %s: %w", c.ID, e.TxHash, err)
+	}
+	if role, ok := decodeRole(tx, c.PayMaster); ok {
+		return role, nil
+	}
+
+	for _, role := range knownRoles {
+		addr, err := c.resolve(ctx, role)
+		if err != nil {
+			return Role{}, err
+		}
+		if addr == e.Receiver {
+			return role, nil
+		}
+	}
+	return Role{}, nil
+}
+
+// decodeRole reads superRegistryId_ from a direct withdrawTo/withdrawNativeTo call to payMaster.
+func decodeRole(tx *types.Transaction, payMaster common.Address) (Role, bool) {
+	if tx.To() == nil || *tx.To() != payMaster || len(tx.Data()) < 4 {
+		return Role{}, false
+	}
+	parsed, err := contracts.PayMasterMetaData.GetAbi()
+	if err != nil {
+		return Role{}, false
+	}
+	method, err := parsed.MethodById(tx.Data()[:4])
+	if err != nil || (method.Name != string(WithdrawTo) && method.Name != string(WithdrawNativeTo)) {
+		return Role{}, false
+	}
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return Role{}, false
+	}
+	return Role(args[0].([32]byte)), true
+}
@@ -0,0 +1,192 @@
+package treasury
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// ErrInvalidQuote is returned when a quoted LiqRequest does not bridge the native token PayMaster holds.
+var ErrInvalidQuote = errors.New("treasury: quote does not bridge native token")
+
+// Policy decides which chains run low.
+type Policy struct {
+	// Horizon is how long a chain's PayMaster balance must cover the keeper spend rate. A chain is low when its
+	// balance is below MinBalance or below Horizon worth of spend, whichever is higher.
+	Horizon time.Duration
+}
+
+// Proposal is a PayMaster.rebalanceTo moving Amount of native token from SrcChainID to Role on DstChainID.
+type Proposal struct {
+	SrcChainID uint64
+	DstChainID uint64
+	Role       Role
+	Amount     *big.Int
+}
+
+// QuoteRequest describes the bridge transaction a Quoter must build.
+type QuoteRequest struct {
+	SrcChainID uint64
+	DstChainID uint64
+	// Sender is the PayMaster dispatching the tokens.
+	Sender   common.Address
+	Receiver common.Address
+	Amount   *big.Int
+}
+
+// Quoter builds the LiqRequest for a bridge of native token, e.g. from a bridge aggregator API. The txData
+// receiver must be QuoteRequest.Receiver, PayMaster validates it through the bridge validator.
+type Quoter interface {
+	Quote(ctx context.Context, req QuoteRequest) (contracts.LiqRequest, error)
+}
+
+// floor is the balance under which chain c counts as low.
+func (s *Service) floor(c *chainState, p Policy) *big.Int {
+	floor := new(big.Int)
+	if c.MinBalance != nil {
+		floor.Set(c.MinBalance)
+	}
+	if p.Horizon > 0 {
+		if rate := s.spendRate(c); rate != nil {
+			need := new(big.Int).Mul(rate, big.NewInt(int64(p.Horizon/time.Second)))
+			if need.Cmp(floor) > 0 {
+				floor = need
+			}
+		}
+	}
+	return floor
+}
+
+// target is the balance chain c is topped up to and keeps when giving away excess.
+func (s *Service) target(c *chainState, p Policy) *big.Int {
+	floor := s.floor(c, p)
+	if c.TargetBalance != nil && c.TargetBalance.Cmp(floor) > 0 {
+		return new(big.Int).Set(c.TargetBalance)
+	}
+	return floor
+}
+
+// Plan proposes rebalances from chains holding more than their target to chains below their floor, largest
+// deficit first. It works on the balances of the last Poll; chains never polled are left out.
+func (s *Service) Plan(p Policy) []Proposal {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type gap struct {
+		chainID uint64
+		amount  *big.Int
+	}
+	var deficits, surpluses []gap
+	for _, id := range s.ChainIDs() {
+		c := s.chains[id]
+		if c.last == nil {
+			continue
+		}
+		balance := c.last.PayMaster
+		if balance.Cmp(s.floor(c, p)) < 0 {
+			deficits = append(deficits, gap{id, new(big.Int).Sub(s.target(c, p), balance)})
+			continue
+		}
+		if excess := new(big.Int).Sub(balance, s.target(c, p)); excess.Sign() > 0 {
+			surpluses = append(surpluses, gap{id, excess})
+		}
+	}
+	byAmount := func(g []gap) func(i, j int) bool {
+		return func(i, j int) bool { return g[i].amount.Cmp(g[j].amount) > 0 }
+	}
+	sort.SliceStable(deficits, byAmount(deficits))
+	sort.SliceStable(surpluses, byAmount(surpluses))
+
+	var proposals []Proposal
+	for _, d := range deficits {
+		for i := range surpluses {
+			if d.amount.Sign() == 0 {
+				break
+			}
+			src := &surpluses[i]
+			if src.amount.Sign() == 0 {
+				continue
+			}
+			amount := new(big.Int).Set(d.amount)
+			if amount.Cmp(src.amount) > 0 {
+				amount.Set(src.amount)
+			}
+			proposals = append(proposals, Proposal{
+				SrcChainID: src.chainID,
+				DstChainID: d.chainID,
+				Role:       PayMaster,
+				Amount:     amount,
+			})
+			d.amount.Sub(d.amount, amount)
+			src.amount.Sub(src.amount, amount)
+		}
+	}
+	return proposals
+}
+
+func (s *Service) spendRate(c *chainState) *big.Int {
+	if c.last == nil {
+		return nil
+	}
+	elapsed := int64(c.last.Time.Sub(c.started) / time.Second)
+	if elapsed <= 0 || c.last.Spent.Sign() <= 0 {
+		return nil
+	}
+	return new(big.Int).Div(c.last.Spent, big.NewInt(elapsed))
+}
+
+// Execute quotes and sends proposal from opts.From, which must hold PAYMENT_ADMIN_ROLE on the source chain, and
+// records it in the ledger.
+func (s *Service) Execute(opts *bind.TransactOpts, quoter Quoter, proposal Proposal) (*types.Transaction, error) {
+	c, err := s.chain(proposal.SrcChainID)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := c.resolveOn(opts.Context, proposal.Role, proposal.DstChainID)
+	if err != nil {
+		return nil, err
+	}
+	if receiver == (common.Address{}) {
+		return nil, fmt.Errorf("treasury: %s not registered for chain %d on chain %d", proposal.Role, proposal.DstChainID, c.ID)
+	}
+
+	req, err := quoter.Quote(opts.Context, QuoteRequest{
+		SrcChainID: proposal.SrcChainID,
+		DstChainID: proposal.DstChainID,
+		Sender:     c.PayMaster,
+		Receiver:   receiver,
+		Amount:     proposal.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Token != Native || req.NativeAmount == nil || req.NativeAmount.Cmp(proposal.Amount) < 0 {
+		return nil, fmt.Errorf("%w: token %s native amount %v", ErrInvalidQuote, req.Token, req.NativeAmount)
+	}
+
+	tx, err := c.payMaster.RebalanceTo(opts, proposal.Role, req, proposal.DstChainID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.ledger.Record(Entry{
+		Kind:       RebalanceTo,
+		ChainID:    c.ID,
+		Role:       proposal.Role,
+		RoleName:   proposal.Role.String(),
+		Receiver:   receiver,
+		Token:      Native,
+		Amount:     req.NativeAmount,
+		DstChainID: proposal.DstChainID,
+		TxHash:     tx.Hash(),
+	})
+	return tx, err
+}
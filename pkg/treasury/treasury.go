@@ -0,0 +1,353 @@
+// Package treasury tracks PayMaster balances on every chain against the gas the keepers of that chain spend,
+// proposes or executes PayMaster.rebalanceTo bridging towards chains running low and keeps a ledger of every
+// PayMaster withdrawal keyed by the SuperRegistry id of its receiver.
+package treasury
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/superform-core/contracts"
+)
+
+// Native is the token address LiquidityHandler uses for the native token.
+var Native = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// Role is a SuperRegistry address id, e.g. keccak256("CORE_REGISTRY_PROCESSOR").
+type Role [32]byte
+
+// NewRole returns the id SuperRegistry registers name under.
+func NewRole(name string) Role {
+	return Role(crypto.Keccak256Hash([]byte(name)))
+}
+
+// String returns the registry name for known ids and the hex id otherwise.
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return common.Hash(r).Hex()
+}
+
+// MarshalText encodes the role as its hex id.
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(common.Hash(r).Hex()), nil
+}
+
+// UnmarshalText decodes a hex id.
+func (r *Role) UnmarshalText(text []byte) error {
+	var h common.Hash
+	if err := h.UnmarshalText(text); err != nil {
+		return err
+	}
+	*r = Role(h)
+	return nil
+}
+
+// Receiver ids PayMaster withdrawals and rebalances are commonly sent to.
+var (
+	PayMaster                  = NewRole("PAYMASTER")
+	PaymentAdmin               = NewRole("PAYMENT_ADMIN")
+	CoreRegistryProcessor      = NewRole("CORE_REGISTRY_PROCESSOR")
+	CoreRegistryUpdater        = NewRole("CORE_REGISTRY_UPDATER")
+	CoreRegistryRescuer        = NewRole("CORE_REGISTRY_RESCUER")
+	CoreRegistryDisputer       = NewRole("CORE_REGISTRY_DISPUTER")
+	BroadcastRegistryProcessor = NewRole("BROADCAST_REGISTRY_PROCESSOR")
+	TimelockRegistryProcessor  = NewRole("TIMELOCK_REGISTRY_PROCESSOR")
+	DstSwapperProcessor        = NewRole("DST_SWAPPER_PROCESSOR")
+)
+
+var roleNames = map[Role]string{
+	PayMaster:                  "PAYMASTER",
+	PaymentAdmin:               "PAYMENT_ADMIN",
+	CoreRegistryProcessor:      "CORE_REGISTRY_PROCESSOR",
+	CoreRegistryUpdater:        "CORE_REGISTRY_UPDATER",
+	CoreRegistryRescuer:        "CORE_REGISTRY_RESCUER",
+	CoreRegistryDisputer:       "CORE_REGISTRY_DISPUTER",
+	BroadcastRegistryProcessor: "BROADCAST_REGISTRY_PROCESSOR",
+	TimelockRegistryProcessor:  "TIMELOCK_REGISTRY_PROCESSOR",
+	DstSwapperProcessor:        "DST_SWAPPER_PROCESSOR",
+}
+
+// knownRoles lists roleNames in a fixed order, so receivers shared by several roles resolve deterministically.
+var knownRoles = []Role{
+	PayMaster,
+	PaymentAdmin,
+	CoreRegistryProcessor,
+	CoreRegistryUpdater,
+	CoreRegistryRescuer,
+	CoreRegistryDisputer,
+	BroadcastRegistryProcessor,
+	TimelockRegistryProcessor,
+	DstSwapperProcessor,
+}
+
+// Keepers are the roles whose addresses send keeper transactions and are funded from PayMaster.
+var Keepers = []Role{
+	CoreRegistryProcessor,
+	CoreRegistryUpdater,
+	CoreRegistryRescuer,
+	CoreRegistryDisputer,
+	BroadcastRegistryProcessor,
+	TimelockRegistryProcessor,
+	DstSwapperProcessor,
+}
+
+// Backend is the chain access the service needs.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+}
+
+// Chain configures one chain of the treasury.
+type Chain struct {
+	ID            uint64
+	Backend       Backend
+	PayMaster     common.Address
+	SuperRegistry common.Address
+	// FromBlock is the first block whose PayMaster withdrawals are recorded in the ledger.
+	FromBlock uint64
+	// Keepers overrides the funded roles, defaulting to the package Keepers.
+	Keepers []Role
+	// MinBalance is the PayMaster native balance below which the chain asks for a rebalance.
+	MinBalance *big.Int
+	// TargetBalance is the balance a rebalance tops the chain up to. Chains above it can give away the excess.
+	TargetBalance *big.Int
+}
+
+func (c Chain) keepers() []Role {
+	if len(c.Keepers) > 0 {
+		return c.Keepers
+	}
+	return Keepers
+}
+
+// Balance is the treasury position of one chain at a point in time.
+type Balance struct {
+	ChainID uint64
+	Block   uint64
+	Time    time.Time
+	// PayMaster is the native balance of PayMaster.
+	PayMaster *big.Int
+	// Keepers is the native balance of every keeper role address.
+	Keepers map[Role]*big.Int
+	// Spent is the keeper gas spent since the first poll: the keeper balance decrease not explained by native
+	// withdrawals from PayMaster. Native funding from elsewhere is counted as negative spend.
+	Spent *big.Int
+}
+
+// KeeperTotal sums the keeper balances.
+func (b Balance) KeeperTotal() *big.Int {
+	total := new(big.Int)
+	for _, v := range b.Keepers {
+		total.Add(total, v)
+	}
+	return total
+}
+
+type chainState struct {
+	Chain
+	payMaster *contracts.PayMaster
+	registry  *contracts.SuperRegistryCaller
+
+	keeperAddrs map[Role]common.Address
+	// block is the next block to sync into the ledger.
+	block   uint64
+	started time.Time
+	last    *Balance
+}
+
+// Service tracks the PayMaster treasury of every configured chain.
+type Service struct {
+	chains map[uint64]*chainState
+	ledger *Ledger
+
+	mu sync.Mutex
+}
+
+// NewService creates a Service over chains recording withdrawals to ledger.
+func NewService(chains []Chain, ledger *Ledger) (*Service, error) {
+	s := &Service{chains: make(map[uint64]*chainState), ledger: ledger}
+	for _, c := range chains {
+		pm, err := contracts.NewPayMaster(c.PayMaster, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		registry, err := contracts.NewSuperRegistryCaller(c.SuperRegistry, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		s.chains[c.ID] = &chainState{
+			Chain:     c,
+			payMaster: pm,
+			registry:  registry,
+			block:     c.FromBlock,
+		}
+	}
+	return s, nil
+}
+
+// ChainIDs returns the configured chains in ascending order.
+func (s *Service) ChainIDs() []uint64 {
+	ids := make([]uint64, 0, len(s.chains))
+	for id := range s.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (s *Service) chain(chainID uint64) (*chainState, error) {
+	c, ok := s.chains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("treasury: unknown chain %d", chainID)
+	}
+	return c, nil
+}
+
+// ResolveRole returns the address SuperRegistry registers role under on chainID.
+func (s *Service) ResolveRole(ctx context.Context, chainID uint64, role Role) (common.Address, error) {
+	c, err := s.chain(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return c.resolve(ctx, role)
+}
+
+func (c *chainState) resolve(ctx context.Context, role Role) (common.Address, error) {
+	addr, err := c.registry.GetAddress(&bind.CallOpts{Context: ctx}, role)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("chain %d role %s: %w", c.ID, role, err)
+	}
+	return addr, nil
+}
+
+// resolveOn returns the address the chain's SuperRegistry records for role on dstChainID, which is what
+// rebalanceTo validates the bridge receiver against.
+func (c *chainState) resolveOn(ctx context.Context, role Role, dstChainID uint64) (common.Address, error) {
+	addr, err := c.registry.GetAddressByChainId(&bind.CallOpts{Context: ctx}, role, dstChainID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("chain %d role %s on %d: %w", c.ID, role, dstChainID, err)
+	}
+	return addr, nil
+}
+
+// Poll syncs the ledger of every chain up to its head, reads the PayMaster and keeper balances at that block and
+// folds the keeper spend since the previous poll into the running totals.
+func (s *Service) Poll(ctx context.Context) ([]Balance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []Balance
+	for _, id := range s.ChainIDs() {
+		b, err := s.poll(ctx, s.chains[id])
+		if err != nil {
+			return out, err
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+func (s *Service) poll(ctx context.Context, c *chainState) (Balance, error) {
+	if c.keeperAddrs == nil {
+		c.keeperAddrs = make(map[Role]common.Address)
+		for _, role := range c.keepers() {
+			addr, err := c.resolve(ctx, role)
+			if err != nil {
+				return Balance{}, err
+			}
+			if addr != (common.Address{}) {
+				c.keeperAddrs[role] = addr
+			}
+		}
+	}
+
+	head, err := c.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return Balance{}, fmt.Errorf("chain %d head: %w", c.ID, err)
+	}
+
+	// native PayMaster paid out to keepers is funding, not spend
+	funded := new(big.Int)
+	if head.Number.Uint64() >= c.block {
+		entries, err := s.ledger.sync(ctx, c, c.block, head.Number.Uint64())
+		if err != nil {
+			return Balance{}, err
+		}
+		for _, e := range entries {
+			if e.Token != Native {
+				continue
+			}
+			for _, addr := range c.keeperAddrs {
+				if e.Receiver == addr {
+					funded.Add(funded, e.Amount)
+					break
+				}
+			}
+		}
+		c.block = head.Number.Uint64() + 1
+	}
+
+	b := Balance{
+		ChainID: c.ID,
+		Block:   head.Number.Uint64(),
+		Time:    time.Unix(int64(head.Time), 0),
+		Keepers: make(map[Role]*big.Int),
+		Spent:   new(big.Int),
+	}
+	if b.PayMaster, err = c.Backend.BalanceAt(ctx, c.PayMaster, head.Number); err != nil {
+		return Balance{}, fmt.Errorf("chain %d paymaster balance: %w", c.ID, err)
+	}
+	for role, addr := range c.keeperAddrs {
+		if b.Keepers[role], err = c.Backend.BalanceAt(ctx, addr, head.Number); err != nil {
+			return Balance{}, fmt.Errorf("chain %d %s balance: %w", c.ID, role, err)
+		}
+	}
+
+	if c.last == nil {
+		c.started = b.Time
+	} else {
+		spent := new(big.Int).Sub(c.last.KeeperTotal(), b.KeeperTotal())
+		spent.Add(spent, funded)
+		b.Spent.Add(c.last.Spent, spent)
+	}
+	c.last = &b
+	return b, nil
+}
+
+// SpendRate returns the average keeper spend per second of chainID since the first poll, nil while no spend has
+// been observed yet.
+func (s *Service) SpendRate(chainID uint64) *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.chains[chainID]
+	if !ok {
+		return nil
+	}
+	return s.spendRate(c)
+}
+
+// Last returns the most recent balance of chainID, false before the first poll.
+func (s *Service) Last(chainID uint64) (Balance, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.chains[chainID]
+	if !ok || c.last == nil {
+		return Balance{}, false
+	}
+	return *c.last, true
+}